@@ -42,6 +42,7 @@ import (
 	"github.com/gravitational/trace"
 	"github.com/gravitational/trace/trail"
 	"github.com/jonboulle/clockwork"
+	log "github.com/sirupsen/logrus"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"golang.org/x/crypto/ssh"
 	"google.golang.org/grpc"
@@ -137,6 +138,7 @@ func newClient(cfg Config, dialer ContextDialer, tlsConfig *tls.Config) *Client
 // no dialer is given, the first address will be used. This address must
 // be an auth server address.
 func connectInBackground(ctx context.Context, cfg Config) (*Client, error) {
+	warnOnExpiredCredentials(cfg, cfg.Credentials[0])
 	tlsConfig, err := cfg.Credentials[0].TLSConfig()
 	if err != nil {
 		return nil, trace.Wrap(err)
@@ -200,6 +202,8 @@ func connect(ctx context.Context, cfg Config) (*Client, error) {
 
 		// Connect with provided credentials.
 		for _, creds := range cfg.Credentials {
+			warnOnExpiredCredentials(cfg, creds)
+
 			tlsConfig, err := creds.TLSConfig()
 			if err != nil {
 				sendError(trace.Wrap(err))
@@ -292,6 +296,28 @@ func connect(ctx context.Context, cfg Config) (*Client, error) {
 	return nil, trace.Wrap(trace.NewAggregate(errs...), "all connection methods failed")
 }
 
+// warnOnExpiredCredentials checks credentials that implement
+// ExpiringCredentials and warns, or invokes cfg.OnCredentialExpiring, when
+// the credentials are within cfg.CredentialExpiryWindow of expiring.
+func warnOnExpiredCredentials(cfg Config, creds Credentials) {
+	if cfg.CredentialExpiryWindow <= 0 {
+		return
+	}
+	expiringCreds, ok := creds.(ExpiringCredentials)
+	if !ok {
+		return
+	}
+	expiry, ok := expiringCreds.Expiry()
+	if !ok || time.Until(expiry) > cfg.CredentialExpiryWindow {
+		return
+	}
+	if cfg.OnCredentialExpiring != nil {
+		cfg.OnCredentialExpiring(expiry)
+		return
+	}
+	log.Warnf("Teleport credentials expire at %v, consider renewing them.", expiry)
+}
+
 type (
 	connectFunc   func(ctx context.Context, params connectParams) (*Client, error)
 	connectParams struct {
@@ -503,6 +529,14 @@ type Config struct {
 	CircuitBreakerConfig breaker.Config
 	// Context is the base context to use for dialing. If not provided context.Background is used
 	Context context.Context
+	// CredentialExpiryWindow, if set, makes the client check credentials that
+	// implement ExpiringCredentials and warn when a credential is within this
+	// window of expiring.
+	CredentialExpiryWindow time.Duration
+	// OnCredentialExpiring is invoked instead of logging a warning when a
+	// credential is within CredentialExpiryWindow of expiring. Ignored if
+	// CredentialExpiryWindow is zero.
+	OnCredentialExpiring func(expiry time.Time)
 }
 
 // CheckAndSetDefaults checks and sets default config values.
@@ -19,7 +19,9 @@ package client
 import (
 	"crypto/tls"
 	"crypto/x509"
+	"encoding/pem"
 	"os"
+	"time"
 
 	"github.com/gravitational/teleport/api/constants"
 	"github.com/gravitational/teleport/api/identityfile"
@@ -46,6 +48,18 @@ type Credentials interface {
 	SSHClientConfig() (*ssh.ClientConfig, error)
 }
 
+// ExpiringCredentials is an optional interface implemented by Credentials
+// whose active certificate has a known expiry.
+//
+// Callers should check the boolean return value - loaders may not be able to
+// determine an expiry, for example before lazily loaded credentials are read
+// from disk.
+type ExpiringCredentials interface {
+	// Expiry returns the expiry time of the credentials' TLS certificate.
+	// The boolean is false if the expiry could not be determined.
+	Expiry() (time.Time, bool)
+}
+
 // LoadTLS is used to load Credentials directly from a *tls.Config.
 //
 // TLS creds can only be used to connect directly to a Teleport Auth server.
@@ -78,6 +92,22 @@ func (c *tlsConfigCreds) SSHClientConfig() (*ssh.ClientConfig, error) {
 	return nil, trace.NotImplemented("no ssh config")
 }
 
+// Expiry returns the expiry of the credentials' TLS certificate.
+func (c *tlsConfigCreds) Expiry() (time.Time, bool) {
+	if c.tlsConfig == nil || len(c.tlsConfig.Certificates) == 0 {
+		return time.Time{}, false
+	}
+	cert := c.tlsConfig.Certificates[0]
+	if len(cert.Certificate) == 0 {
+		return time.Time{}, false
+	}
+	x509Cert, err := x509.ParseCertificate(cert.Certificate[0])
+	if err != nil {
+		return time.Time{}, false
+	}
+	return x509Cert.NotAfter, true
+}
+
 // LoadKeyPair is used to load Credentials from a certicate keypair on disk.
 //
 // KeyPair Credentials can only be used to connect directly to a Teleport Auth server.
@@ -136,6 +166,15 @@ func (c *keypairCreds) SSHClientConfig() (*ssh.ClientConfig, error) {
 	return nil, trace.NotImplemented("no ssh config")
 }
 
+// Expiry returns the expiry of the credentials' TLS certificate.
+func (c *keypairCreds) Expiry() (time.Time, bool) {
+	certPEM, err := os.ReadFile(c.certFile)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return expiryFromTLSCertPEM(certPEM)
+}
+
 // LoadIdentityFile is used to load Credentials from an identity file on disk.
 //
 // Identity Credentials can be used to connect to an auth server directly
@@ -193,6 +232,14 @@ func (c *identityCredsFile) SSHClientConfig() (*ssh.ClientConfig, error) {
 	return sshConfig, nil
 }
 
+// Expiry returns the expiry of the credentials' TLS certificate.
+func (c *identityCredsFile) Expiry() (time.Time, bool) {
+	if err := c.load(); err != nil {
+		return time.Time{}, false
+	}
+	return expiryFromTLSCertPEM(c.identityFile.Certs.TLS)
+}
+
 // load is used to lazy load the identity file from persistent storage.
 // This allows LoadIdentity to avoid possible errors for UX purposes.
 func (c *identityCredsFile) load() error {
@@ -263,6 +310,14 @@ func (c *identityCredsString) SSHClientConfig() (*ssh.ClientConfig, error) {
 	return sshConfig, nil
 }
 
+// Expiry returns the expiry of the credentials' TLS certificate.
+func (c *identityCredsString) Expiry() (time.Time, bool) {
+	if err := c.load(); err != nil {
+		return time.Time{}, false
+	}
+	return expiryFromTLSCertPEM(c.identityFile.Certs.TLS)
+}
+
 // load is used to lazy load the identity file from a string.
 func (c *identityCredsString) load() error {
 	if c.identityFile != nil {
@@ -347,6 +402,18 @@ func (c *profileCreds) SSHClientConfig() (*ssh.ClientConfig, error) {
 	return sshConfig, nil
 }
 
+// Expiry returns the expiry of the credentials' TLS certificate.
+func (c *profileCreds) Expiry() (time.Time, bool) {
+	if err := c.load(); err != nil {
+		return time.Time{}, false
+	}
+	certPEM, err := os.ReadFile(c.profile.TLSCertPath())
+	if err != nil {
+		return time.Time{}, false
+	}
+	return expiryFromTLSCertPEM(certPEM)
+}
+
 // load is used to lazy load the profile from persistent storage.
 // This allows LoadProfile to avoid possible errors for UX purposes.
 func (c *profileCreds) load() error {
@@ -360,6 +427,20 @@ func (c *profileCreds) load() error {
 	return nil
 }
 
+// expiryFromTLSCertPEM parses a PEM encoded TLS certificate and returns its
+// expiry time.
+func expiryFromTLSCertPEM(certPEM []byte) (time.Time, bool) {
+	block, _ := pem.Decode(certPEM)
+	if block == nil {
+		return time.Time{}, false
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return cert.NotAfter, true
+}
+
 func configureTLS(c *tls.Config) *tls.Config {
 	tlsConfig := c.Clone()
 	tlsConfig.NextProtos = utils.Deduplicate(append(tlsConfig.NextProtos, http2.NextProtoTLS))
@@ -0,0 +1,404 @@
+/*
+Copyright 2022 Gravitational, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gravitational/teleport/api/defaults"
+	"github.com/gravitational/teleport/api/identityfile"
+	"github.com/gravitational/teleport/api/utils/keys"
+
+	"github.com/gravitational/trace"
+	"golang.org/x/crypto/ssh"
+)
+
+// SecondFactorProvider supplies a second factor token during a web login.
+// It is called at most once per login attempt, for example to read an OTP
+// code from a hardware token or an external secret store.
+type SecondFactorProvider func(ctx context.Context) (otpToken string, err error)
+
+// WebauthnLoginProvider solves a webauthn assertion challenge issued by the
+// proxy during a web login. The challenge and the returned assertion response
+// are the JSON payloads exchanged with the proxy web API; they are kept opaque
+// here so callers can plug in any webauthn client implementation.
+type WebauthnLoginProvider func(ctx context.Context, assertion json.RawMessage) (response json.RawMessage, err error)
+
+// WebLoginConfig configures a web login credentials loader.
+type WebLoginConfig struct {
+	// ProxyAddr is the host:port of the Teleport web proxy.
+	ProxyAddr string
+	// User is the Teleport user name to log in as.
+	User string
+	// Password is the user's password.
+	Password string
+	// SecondFactor optionally supplies an OTP token for users with
+	// second factor authentication enabled.
+	SecondFactor SecondFactorProvider
+	// Webauthn optionally solves webauthn challenges for users with
+	// webauthn second factors. When set, the login goes through the
+	// proxy's challenge-response MFA endpoints and SecondFactor is ignored.
+	Webauthn WebauthnLoginProvider
+	// TTL is the requested time to live of the issued certificates.
+	// The server may cap it. Zero means the server default.
+	TTL time.Duration
+	// Insecure skips verification of the proxy's TLS certificate chain
+	// during login. Do not use in production.
+	Insecure bool
+	// Pool overrides the CA pool used to verify the proxy during login.
+	Pool *x509.CertPool
+}
+
+// CheckAndSetDefaults checks and sets default config values.
+func (c *WebLoginConfig) CheckAndSetDefaults() error {
+	switch {
+	case c.ProxyAddr == "":
+		return trace.BadParameter("web login credentials require a proxy address")
+	case c.User == "":
+		return trace.BadParameter("web login credentials require a user")
+	case c.Password == "":
+		return trace.BadParameter("web login credentials require a password")
+	}
+	return nil
+}
+
+// LoadWebLogin is used to load Credentials by performing a web login
+// (password and optional second factor) against the proxy.
+//
+// The resulting certificates are cached in memory for the lifetime of the
+// loader, allowing headless services to bootstrap a client without
+// pre-generated identity files. The login is performed lazily on first use.
+func LoadWebLogin(cfg WebLoginConfig) Credentials {
+	return &webLoginCreds{cfg: cfg}
+}
+
+// webLoginCreds performs a web login against the proxy and uses the
+// issued certificates to provide client credentials.
+type webLoginCreds struct {
+	cfg WebLoginConfig
+
+	// mu guards identityFile and makes sure concurrent credential loads
+	// perform a single login.
+	mu           sync.Mutex
+	identityFile *identityfile.IdentityFile
+}
+
+// Dialer is used to dial a connection to an Auth server.
+func (c *webLoginCreds) Dialer(cfg Config) (ContextDialer, error) {
+	sshConfig, err := c.SSHClientConfig()
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	return NewProxyDialer(
+		*sshConfig,
+		cfg.KeepAlivePeriod,
+		cfg.DialTimeout,
+		c.cfg.ProxyAddr,
+		cfg.InsecureAddressDiscovery,
+	), nil
+}
+
+// TLSConfig returns TLS configuration.
+func (c *webLoginCreds) TLSConfig() (*tls.Config, error) {
+	idFile, err := c.login()
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	tlsConfig, err := idFile.TLSConfig()
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	return configureTLS(tlsConfig), nil
+}
+
+// SSHClientConfig returns SSH configuration.
+func (c *webLoginCreds) SSHClientConfig() (*ssh.ClientConfig, error) {
+	idFile, err := c.login()
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	sshConfig, err := idFile.SSHClientConfig()
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	return sshConfig, nil
+}
+
+// Expiry returns the expiry of the credentials' TLS certificate.
+// It returns false before the initial login is performed.
+func (c *webLoginCreds) Expiry() (time.Time, bool) {
+	c.mu.Lock()
+	idFile := c.identityFile
+	c.mu.Unlock()
+	if idFile == nil {
+		return time.Time{}, false
+	}
+	return expiryFromTLSCertPEM(idFile.Certs.TLS)
+}
+
+// login performs the web login and caches the resulting certificates.
+// Subsequent calls reuse the cached certificates.
+func (c *webLoginCreds) login() (*identityfile.IdentityFile, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.identityFile != nil {
+		return c.identityFile, nil
+	}
+	if err := c.cfg.CheckAndSetDefaults(); err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	// The Credentials interface does not thread a context through, so bound
+	// the login instead of leaving it open-ended.
+	ctx, cancel := context.WithTimeout(context.Background(), defaults.DefaultDialTimeout)
+	defer cancel()
+
+	priv, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+	keyPEM := pem.EncodeToMemory(&pem.Block{
+		Type:  "RSA PRIVATE KEY",
+		Bytes: x509.MarshalPKCS1PrivateKey(priv),
+	})
+	sshPub, err := ssh.NewPublicKey(priv.Public())
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+	pubKey := ssh.MarshalAuthorizedKey(sshPub)
+
+	var resp *webSessionCertResponse
+	if c.cfg.Webauthn != nil {
+		resp, err = c.issueCertsMFA(ctx, pubKey)
+	} else {
+		var otpToken string
+		if c.cfg.SecondFactor != nil {
+			if otpToken, err = c.cfg.SecondFactor(ctx); err != nil {
+				return nil, trace.Wrap(err)
+			}
+		}
+		resp, err = c.issueCerts(ctx, &createWebSessionCertReq{
+			User:     c.cfg.User,
+			Password: c.cfg.Password,
+			OTPToken: otpToken,
+			PubKey:   pubKey,
+			TTL:      c.cfg.TTL,
+		})
+	}
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	idFile := &identityfile.IdentityFile{
+		PrivateKey: keyPEM,
+		Certs: identityfile.Certs{
+			SSH: resp.Cert,
+			TLS: resp.TLSCert,
+		},
+	}
+	for _, ca := range resp.HostSigners {
+		idFile.CACerts.SSH = append(idFile.CACerts.SSH, ca.HostCertificates...)
+		idFile.CACerts.TLS = append(idFile.CACerts.TLS, ca.TLSCertificates...)
+	}
+
+	// Validate the response before caching it so a broken login is retried
+	// rather than reused.
+	if _, err := keys.X509KeyPair(idFile.Certs.TLS, idFile.PrivateKey); err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	c.identityFile = idFile
+	return idFile, nil
+}
+
+// issueCerts performs the password and optional OTP login request against
+// the proxy web API.
+func (c *webLoginCreds) issueCerts(ctx context.Context, req *createWebSessionCertReq) (*webSessionCertResponse, error) {
+	respBody, err := c.post(ctx, "/v1/webapi/ssh/certs", req)
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	var resp webSessionCertResponse
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return nil, trace.Wrap(err)
+	}
+	return &resp, nil
+}
+
+// issueCertsMFA performs a challenge-response login against the proxy web
+// API for users with webauthn second factors.
+func (c *webLoginCreds) issueCertsMFA(ctx context.Context, pubKey []byte) (*webSessionCertResponse, error) {
+	beginBody, err := c.post(ctx, "/v1/webapi/mfa/login/begin", &webMFAChallengeReq{
+		User: c.cfg.User,
+		Pass: c.cfg.Password,
+	})
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+	var challenge webMFAChallenge
+	if err := json.Unmarshal(beginBody, &challenge); err != nil {
+		return nil, trace.Wrap(err)
+	}
+	if len(challenge.WebauthnChallenge) == 0 || bytes.Equal(challenge.WebauthnChallenge, []byte("null")) {
+		return nil, trace.BadParameter("proxy did not offer a webauthn challenge, check the cluster's second factor settings")
+	}
+
+	assertionResp, err := c.cfg.Webauthn(ctx, challenge.WebauthnChallenge)
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	finishBody, err := c.post(ctx, "/v1/webapi/mfa/login/finish", &webMFAFinishReq{
+		User:                      c.cfg.User,
+		WebauthnChallengeResponse: assertionResp,
+		PubKey:                    pubKey,
+		TTL:                       c.cfg.TTL,
+	})
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	var resp webSessionCertResponse
+	if err := json.Unmarshal(finishBody, &resp); err != nil {
+		return nil, trace.Wrap(err)
+	}
+	return &resp, nil
+}
+
+// post sends a JSON request to the given proxy web API endpoint and returns
+// the response body.
+func (c *webLoginCreds) post(ctx context.Context, path string, req interface{}) ([]byte, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	endpoint := fmt.Sprintf("https://%s%s", c.cfg.ProxyAddr, path)
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	clt := &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{
+				InsecureSkipVerify: c.cfg.Insecure,
+				RootCAs:            c.cfg.Pool,
+			},
+		},
+	}
+	httpResp, err := clt.Do(httpReq)
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+	defer httpResp.Body.Close()
+
+	respBody, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+	if httpResp.StatusCode != http.StatusOK {
+		return nil, trace.AccessDenied("web login failed: %v", string(respBody))
+	}
+	return respBody, nil
+}
+
+// createWebSessionCertReq mirrors the proxy's certificate issuance request.
+type createWebSessionCertReq struct {
+	// User is a teleport username.
+	User string `json:"user"`
+	// Password is the user's password.
+	Password string `json:"password"`
+	// OTPToken is the second factor token.
+	OTPToken string `json:"otp_token,omitempty"`
+	// PubKey is the public key to sign in ssh authorized_keys format.
+	PubKey []byte `json:"pub_key"`
+	// TTL is the desired time to live for the certificates.
+	TTL time.Duration `json:"ttl"`
+}
+
+// webMFAChallengeReq mirrors the proxy's MFA login challenge request.
+type webMFAChallengeReq struct {
+	// User is a teleport username.
+	User string `json:"user"`
+	// Pass is the user's password.
+	Pass string `json:"pass"`
+}
+
+// webMFAChallenge mirrors the proxy's MFA login challenge response. The
+// webauthn assertion is kept as raw JSON and handed to the configured
+// WebauthnLoginProvider.
+type webMFAChallenge struct {
+	// WebauthnChallenge is a webauthn credential assertion.
+	WebauthnChallenge json.RawMessage `json:"webauthn_challenge"`
+	// TOTPChallenge specifies whether TOTP is supported for this user.
+	TOTPChallenge bool `json:"totp_challenge"`
+}
+
+// webMFAFinishReq mirrors the proxy's MFA login certificate issuance request.
+type webMFAFinishReq struct {
+	// User is a teleport username.
+	User string `json:"user"`
+	// WebauthnChallengeResponse is a signed webauthn credential assertion.
+	WebauthnChallengeResponse json.RawMessage `json:"webauthn_challenge_response,omitempty"`
+	// PubKey is the public key to sign in ssh authorized_keys format.
+	PubKey []byte `json:"pub_key"`
+	// TTL is the desired time to live for the certificates.
+	TTL time.Duration `json:"ttl"`
+}
+
+// webSessionCertResponse mirrors the proxy's certificate issuance response.
+type webSessionCertResponse struct {
+	// Username is the logged-in user.
+	Username string `json:"username"`
+	// Cert is a PEM encoded SSH certificate.
+	Cert []byte `json:"cert"`
+	// TLSCert is a PEM encoded TLS certificate.
+	TLSCert []byte `json:"tls_cert"`
+	// HostSigners is a list of signing host public keys trusted by the proxy.
+	HostSigners []webSessionTrustedCerts `json:"host_signers"`
+}
+
+// webSessionTrustedCerts contains host certificates. The non-matching json
+// tags preserve backwards compatibility on the wire.
+type webSessionTrustedCerts struct {
+	// ClusterName identifies the cluster the certificates belong to.
+	ClusterName string `json:"domain_name"`
+	// HostCertificates is a list of SSH CA public keys in authorized_keys format.
+	HostCertificates [][]byte `json:"checking_keys"`
+	// TLSCertificates is a list of PEM encoded TLS CA certificates.
+	TLSCertificates [][]byte `json:"tls_certs"`
+}
@@ -0,0 +1,310 @@
+/*
+Copyright 2022 Gravitational, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+)
+
+// stubWebProxy is a minimal proxy web API used to exercise web login
+// credentials. It signs whatever public key the client sends with its own
+// throwaway certificate authorities.
+type stubWebProxy struct {
+	t   *testing.T
+	srv *httptest.Server
+
+	caKey  *ecdsa.PrivateKey
+	caCert *x509.Certificate
+	caPEM  []byte
+	sshCA  ssh.Signer
+
+	user      string
+	password  string
+	otpToken  string
+	challenge json.RawMessage
+	assertion json.RawMessage
+
+	mu     sync.Mutex
+	logins int
+}
+
+func newStubWebProxy(t *testing.T) *stubWebProxy {
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "stub-proxy-ca"},
+		NotBefore:             time.Now().Add(-time.Minute),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, caKey.Public(), caKey)
+	require.NoError(t, err)
+	caCert, err := x509.ParseCertificate(caDER)
+	require.NoError(t, err)
+
+	sshCA, err := ssh.NewSignerFromKey(caKey)
+	require.NoError(t, err)
+
+	p := &stubWebProxy{
+		t:      t,
+		caKey:  caKey,
+		caCert: caCert,
+		caPEM:  pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caDER}),
+		sshCA:  sshCA,
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/webapi/ssh/certs", p.handleCerts)
+	mux.HandleFunc("/v1/webapi/mfa/login/begin", p.handleMFABegin)
+	mux.HandleFunc("/v1/webapi/mfa/login/finish", p.handleMFAFinish)
+	p.srv = httptest.NewTLSServer(mux)
+	t.Cleanup(p.srv.Close)
+
+	return p
+}
+
+// addr returns the host:port of the stub proxy.
+func (p *stubWebProxy) addr() string {
+	return strings.TrimPrefix(p.srv.URL, "https://")
+}
+
+// pool returns a CA pool trusting the stub proxy's TLS certificate.
+func (p *stubWebProxy) pool() *x509.CertPool {
+	pool := x509.NewCertPool()
+	pool.AddCert(p.srv.Certificate())
+	return pool
+}
+
+// loginCount returns the number of completed logins.
+func (p *stubWebProxy) loginCount() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.logins
+}
+
+func (p *stubWebProxy) handleCerts(w http.ResponseWriter, r *http.Request) {
+	var req createWebSessionCertReq
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if req.User != p.user || req.Password != p.password || req.OTPToken != p.otpToken {
+		http.Error(w, "invalid credentials", http.StatusForbidden)
+		return
+	}
+	p.writeCerts(w, req.PubKey, req.User)
+}
+
+func (p *stubWebProxy) handleMFABegin(w http.ResponseWriter, r *http.Request) {
+	var req webMFAChallengeReq
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if req.User != p.user || req.Pass != p.password {
+		http.Error(w, "invalid credentials", http.StatusForbidden)
+		return
+	}
+	json.NewEncoder(w).Encode(&webMFAChallenge{WebauthnChallenge: p.challenge})
+}
+
+func (p *stubWebProxy) handleMFAFinish(w http.ResponseWriter, r *http.Request) {
+	var req webMFAFinishReq
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if req.User != p.user || !bytes.Equal(req.WebauthnChallengeResponse, p.assertion) {
+		http.Error(w, "invalid assertion", http.StatusForbidden)
+		return
+	}
+	p.writeCerts(w, req.PubKey, req.User)
+}
+
+// writeCerts signs the client's public key with the stub authorities and
+// writes the certificate response.
+func (p *stubWebProxy) writeCerts(w http.ResponseWriter, pubKey []byte, user string) {
+	sshPub, _, _, _, err := ssh.ParseAuthorizedKey(pubKey)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	cryptoPub := sshPub.(ssh.CryptoPublicKey).CryptoPublicKey()
+
+	leafTemplate := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: user},
+		NotBefore:    time.Now().Add(-time.Minute),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	leafDER, err := x509.CreateCertificate(rand.Reader, leafTemplate, p.caCert, cryptoPub, p.caKey)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	sshCert := &ssh.Certificate{
+		Key:             sshPub,
+		Serial:          1,
+		CertType:        ssh.UserCert,
+		KeyId:           user,
+		ValidPrincipals: []string{user},
+		ValidAfter:      uint64(time.Now().Add(-time.Minute).Unix()),
+		ValidBefore:     uint64(time.Now().Add(time.Hour).Unix()),
+	}
+	if err := sshCert.SignCert(rand.Reader, p.sshCA); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	p.mu.Lock()
+	p.logins++
+	p.mu.Unlock()
+
+	json.NewEncoder(w).Encode(&webSessionCertResponse{
+		Username: user,
+		Cert:     ssh.MarshalAuthorizedKey(sshCert),
+		TLSCert:  pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: leafDER}),
+		HostSigners: []webSessionTrustedCerts{{
+			ClusterName:      "example.com",
+			HostCertificates: [][]byte{[]byte("@cert-authority *.example.com " + string(ssh.MarshalAuthorizedKey(p.sshCA.PublicKey())))},
+			TLSCertificates:  [][]byte{p.caPEM},
+		}},
+	})
+}
+
+// TestWebLoginCredentials verifies the password and OTP login flow and that
+// concurrent credential loads perform a single login.
+func TestWebLoginCredentials(t *testing.T) {
+	t.Parallel()
+
+	proxy := newStubWebProxy(t)
+	proxy.user = "testuser"
+	proxy.password = "secret"
+	proxy.otpToken = "123456"
+
+	creds := LoadWebLogin(WebLoginConfig{
+		ProxyAddr: proxy.addr(),
+		User:      "testuser",
+		Password:  "secret",
+		SecondFactor: func(ctx context.Context) (string, error) {
+			return "123456", nil
+		},
+		Pool: proxy.pool(),
+	})
+
+	// The initial login must not happen before the credentials are used.
+	_, ok := creds.(*webLoginCreds).Expiry()
+	require.False(t, ok)
+	require.Equal(t, 0, proxy.loginCount())
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			tlsConfig, err := creds.TLSConfig()
+			require.NoError(t, err)
+			require.NotNil(t, tlsConfig)
+		}()
+	}
+	wg.Wait()
+
+	sshConfig, err := creds.SSHClientConfig()
+	require.NoError(t, err)
+	require.NotNil(t, sshConfig)
+
+	expiry, ok := creds.(*webLoginCreds).Expiry()
+	require.True(t, ok)
+	require.False(t, expiry.IsZero())
+
+	// All loads above reuse the certificates from a single login.
+	require.Equal(t, 1, proxy.loginCount())
+}
+
+// TestWebLoginCredentialsWebauthn verifies the challenge-response login flow
+// for users with webauthn second factors.
+func TestWebLoginCredentialsWebauthn(t *testing.T) {
+	t.Parallel()
+
+	proxy := newStubWebProxy(t)
+	proxy.user = "testuser"
+	proxy.password = "secret"
+	proxy.challenge = json.RawMessage(`{"publicKey":{"challenge":"dGVzdA"}}`)
+	proxy.assertion = json.RawMessage(`{"id":"credential-id","response":{}}`)
+
+	creds := LoadWebLogin(WebLoginConfig{
+		ProxyAddr: proxy.addr(),
+		User:      "testuser",
+		Password:  "secret",
+		Webauthn: func(ctx context.Context, assertion json.RawMessage) (json.RawMessage, error) {
+			require.JSONEq(t, string(proxy.challenge), string(assertion))
+			return proxy.assertion, nil
+		},
+		Pool: proxy.pool(),
+	})
+
+	tlsConfig, err := creds.TLSConfig()
+	require.NoError(t, err)
+	require.NotNil(t, tlsConfig)
+	require.Equal(t, 1, proxy.loginCount())
+}
+
+// TestWebLoginCredentialsBadPassword verifies that a rejected login is
+// surfaced to the caller and not cached.
+func TestWebLoginCredentialsBadPassword(t *testing.T) {
+	t.Parallel()
+
+	proxy := newStubWebProxy(t)
+	proxy.user = "testuser"
+	proxy.password = "secret"
+
+	creds := LoadWebLogin(WebLoginConfig{
+		ProxyAddr: proxy.addr(),
+		User:      "testuser",
+		Password:  "wrong",
+		Pool:      proxy.pool(),
+	})
+
+	_, err := creds.TLSConfig()
+	require.Error(t, err)
+	_, ok := creds.(*webLoginCreds).Expiry()
+	require.False(t, ok)
+}
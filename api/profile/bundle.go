@@ -0,0 +1,237 @@
+/*
+Copyright 2022 Gravitational, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package profile
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/gravitational/teleport/api/utils/keypaths"
+
+	"github.com/gravitational/trace"
+	"gopkg.in/yaml.v2"
+)
+
+// ExportBundle writes the selected profiles along with their keys and
+// certificates as a gzipped tarball to w, to migrate credentials between
+// machines or into containers. If an encryption passphrase is set with
+// SetEncryptionPassphrase, the bundle is encrypted with it.
+func ExportBundle(dir string, names []string, w io.Writer) error {
+	dir = FullProfilePath(dir)
+	if len(names) == 0 {
+		return trace.BadParameter("cannot export bundle: no profiles selected")
+	}
+
+	var buf bytes.Buffer
+	gzw := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gzw)
+
+	for _, name := range names {
+		// The profile file itself.
+		if err := addFileToBundle(tw, dir, name+".yaml"); err != nil {
+			return trace.Wrap(err)
+		}
+		// The keys and certificates issued for the profile's proxy.
+		keyDir := keypaths.ProxyKeyDir(dir, name)
+		if _, err := os.Stat(keyDir); err == nil {
+			if err := addDirToBundle(tw, dir, keyDir); err != nil {
+				return trace.Wrap(err)
+			}
+		}
+	}
+	// Trusted host CAs are shared between profiles.
+	if _, err := os.Stat(keypaths.KnownHostsPath(dir)); err == nil {
+		if err := addFileToBundle(tw, dir, filepath.Base(keypaths.KnownHostsPath(dir))); err != nil {
+			return trace.Wrap(err)
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return trace.Wrap(err)
+	}
+	if err := gzw.Close(); err != nil {
+		return trace.Wrap(err)
+	}
+
+	data := buf.Bytes()
+	if passphrase := getEncryptionPassphrase(); len(passphrase) != 0 {
+		encrypted, err := encryptProfile(data, passphrase)
+		if err != nil {
+			return trace.Wrap(err)
+		}
+		data = encrypted
+	}
+
+	if _, err := w.Write(data); err != nil {
+		return trace.Wrap(err)
+	}
+	return nil
+}
+
+// ImportBundle reads a bundle produced by ExportBundle from r and unpacks it
+// into the given profile directory. Encrypted bundles require the matching
+// passphrase to be set with SetEncryptionPassphrase.
+func ImportBundle(dir string, r io.Reader) error {
+	dir = FullProfilePath(dir)
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return trace.Wrap(err)
+	}
+	if IsEncrypted(data) {
+		if data, err = decryptProfile(data, getEncryptionPassphrase()); err != nil {
+			return trace.Wrap(err)
+		}
+	}
+
+	gzr, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return trace.Wrap(err)
+	}
+	defer gzr.Close()
+
+	tr := tar.NewReader(gzr)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return trace.Wrap(err)
+		}
+
+		// Reject entries that would escape the profile directory.
+		name := filepath.Clean(header.Name)
+		if filepath.IsAbs(name) || strings.HasPrefix(name, "..") {
+			return trace.BadParameter("bundle contains unsafe path %q", header.Name)
+		}
+		path := filepath.Join(dir, name)
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(path, 0700); err != nil {
+				return trace.ConvertSystemError(err)
+			}
+		case tar.TypeReg:
+			if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+				return trace.ConvertSystemError(err)
+			}
+			contents, err := io.ReadAll(tr)
+			if err != nil {
+				return trace.Wrap(err)
+			}
+			// Hooks run arbitrary shell commands, honoring them from an
+			// untrusted bundle would mean code execution on the next login or
+			// logout. Strip them from the imported profile files.
+			if filepath.Dir(name) == "." && strings.HasSuffix(name, ".yaml") {
+				if contents, err = stripImportedHooks(contents); err != nil {
+					return trace.Wrap(err)
+				}
+			}
+			// The modes recorded in the bundle are untrusted (they could carry
+			// setuid/setgid or world-readable bits), write everything
+			// owner-only and non-executable instead.
+			if err := os.WriteFile(path, contents, 0600); err != nil {
+				return trace.ConvertSystemError(err)
+			}
+		default:
+			return trace.BadParameter("bundle contains unsupported entry type for %q", header.Name)
+		}
+	}
+}
+
+// stripImportedHooks removes the hooks section from a profile file unpacked
+// from a bundle. Encrypted profile files are decrypted, stripped and
+// re-encrypted with the current passphrase.
+func stripImportedHooks(contents []byte) ([]byte, error) {
+	original := contents
+	encrypted := IsEncrypted(contents)
+	if encrypted {
+		decrypted, err := decryptProfile(contents, getEncryptionPassphrase())
+		if err != nil {
+			return nil, trace.Wrap(err)
+		}
+		contents = decrypted
+	}
+
+	var p *Profile
+	if err := yaml.Unmarshal(contents, &p); err != nil {
+		return nil, trace.Wrap(err)
+	}
+	if p == nil || p.Hooks == nil {
+		return original, nil
+	}
+	p.Hooks = nil
+
+	stripped, err := yaml.Marshal(p)
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+	if encrypted {
+		if stripped, err = encryptProfile(stripped, getEncryptionPassphrase()); err != nil {
+			return nil, trace.Wrap(err)
+		}
+	}
+	return stripped, nil
+}
+
+// addFileToBundle adds a single file to the bundle, named relative to dir.
+func addFileToBundle(tw *tar.Writer, dir, relPath string) error {
+	path := filepath.Join(dir, relPath)
+	info, err := os.Stat(path)
+	if err != nil {
+		return trace.ConvertSystemError(err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return trace.ConvertSystemError(err)
+	}
+	if err := tw.WriteHeader(&tar.Header{
+		Name: filepath.ToSlash(relPath),
+		Mode: int64(info.Mode().Perm()),
+		Size: int64(len(data)),
+	}); err != nil {
+		return trace.Wrap(err)
+	}
+	if _, err := tw.Write(data); err != nil {
+		return trace.Wrap(err)
+	}
+	return nil
+}
+
+// addDirToBundle recursively adds a directory to the bundle, named relative
+// to dir.
+func addDirToBundle(tw *tar.Writer, dir, path string) error {
+	return trace.Wrap(filepath.Walk(path, func(filePath string, info fs.FileInfo, err error) error {
+		if err != nil {
+			return trace.Wrap(err)
+		}
+		if info.IsDir() {
+			return nil
+		}
+		relPath, err := filepath.Rel(dir, filePath)
+		if err != nil {
+			return trace.Wrap(err)
+		}
+		return trace.Wrap(addFileToBundle(tw, dir, relPath))
+	}))
+}
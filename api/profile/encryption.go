@@ -0,0 +1,155 @@
+/*
+Copyright 2022 Gravitational, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package profile
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/pem"
+	"sync"
+
+	"github.com/gravitational/trace"
+	"golang.org/x/crypto/scrypt"
+)
+
+// encryptedProfileType is the PEM block type used for encrypted profiles.
+const encryptedProfileType = "ENCRYPTED TELEPORT PROFILE"
+
+// scrypt parameters used to derive the encryption key from the passphrase.
+const (
+	scryptN       = 32768
+	scryptR       = 8
+	scryptP       = 1
+	scryptKeyLen  = 32
+	scryptSaltLen = 16
+)
+
+var (
+	// encryptionMu guards encryptionPassphrase.
+	encryptionMu sync.RWMutex
+	// encryptionPassphrase, when non-empty, is used to encrypt profiles on
+	// save and to transparently decrypt them on load.
+	encryptionPassphrase []byte
+)
+
+// SetEncryptionPassphrase sets the passphrase used to encrypt profiles saved
+// with SaveToDir and to transparently decrypt encrypted profiles in FromDir.
+// Bundles produced by ExportBundle are encrypted with the same passphrase.
+//
+// Only the profile YAML files and exported bundles are encrypted. Key material
+// resolved through the profile's KeyStore stays on disk in plaintext, as other
+// tools consume it directly by path; keeping keys out of plaintext files
+// requires an alternative KeyStore implementation.
+//
+// The passphrase may come from user input or from an OS-provided secret store
+// (e.g. DPAPI or Keychain). Passing an empty passphrase disables encryption;
+// plaintext profiles are always read regardless of this setting.
+func SetEncryptionPassphrase(passphrase []byte) {
+	encryptionMu.Lock()
+	defer encryptionMu.Unlock()
+	encryptionPassphrase = append([]byte(nil), passphrase...)
+}
+
+// getEncryptionPassphrase returns the configured encryption passphrase, if any.
+func getEncryptionPassphrase() []byte {
+	encryptionMu.RLock()
+	defer encryptionMu.RUnlock()
+	return encryptionPassphrase
+}
+
+// IsEncrypted returns true if the given profile file contents are encrypted.
+func IsEncrypted(data []byte) bool {
+	block, _ := pem.Decode(data)
+	return block != nil && block.Type == encryptedProfileType
+}
+
+// encryptProfile encrypts profile YAML with a key derived from the
+// passphrase, producing a PEM encoded payload with the salt and nonce
+// prepended to the ciphertext.
+func encryptProfile(plaintext, passphrase []byte) ([]byte, error) {
+	salt := make([]byte, scryptSaltLen)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	aead, err := newProfileAEAD(passphrase, salt)
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	payload := append(salt, nonce...)
+	payload = aead.Seal(payload, nonce, plaintext, nil)
+
+	return pem.EncodeToMemory(&pem.Block{
+		Type:  encryptedProfileType,
+		Bytes: payload,
+	}), nil
+}
+
+// decryptProfile decrypts a PEM encoded encrypted profile produced by
+// encryptProfile.
+func decryptProfile(data, passphrase []byte) ([]byte, error) {
+	block, _ := pem.Decode(data)
+	if block == nil || block.Type != encryptedProfileType {
+		return nil, trace.BadParameter("profile is not encrypted")
+	}
+	if len(passphrase) == 0 {
+		return nil, trace.BadParameter("profile is encrypted, set a passphrase with profile.SetEncryptionPassphrase")
+	}
+	if len(block.Bytes) < scryptSaltLen {
+		return nil, trace.BadParameter("encrypted profile is malformed")
+	}
+
+	salt, payload := block.Bytes[:scryptSaltLen], block.Bytes[scryptSaltLen:]
+	aead, err := newProfileAEAD(passphrase, salt)
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+	if len(payload) < aead.NonceSize() {
+		return nil, trace.BadParameter("encrypted profile is malformed")
+	}
+
+	nonce, ciphertext := payload[:aead.NonceSize()], payload[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return nil, trace.BadParameter("failed to decrypt profile, check the passphrase")
+	}
+	return plaintext, nil
+}
+
+// newProfileAEAD derives an AES-256-GCM AEAD from the passphrase and salt.
+func newProfileAEAD(passphrase, salt []byte) (cipher.AEAD, error) {
+	key, err := scrypt.Key(passphrase, salt, scryptN, scryptR, scryptP, scryptKeyLen)
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+	blockCipher, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+	aead, err := cipher.NewGCM(blockCipher)
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+	return aead, nil
+}
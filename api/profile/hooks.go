@@ -0,0 +1,59 @@
+/*
+Copyright 2022 Gravitational, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package profile
+
+import (
+	"os"
+	"os/exec"
+	"runtime"
+
+	"github.com/gravitational/trace"
+)
+
+// Hooks configures commands run on profile lifecycle events, for example to
+// regenerate kubeconfigs or SSH configs automatically after login.
+type Hooks struct {
+	// PostLogin are commands run after the profile is saved as the current
+	// profile.
+	PostLogin []string `yaml:"post_login,omitempty" json:"post_login,omitempty"`
+	// PreLogout are commands run before the profile is removed.
+	PreLogout []string `yaml:"pre_logout,omitempty" json:"pre_logout,omitempty"`
+}
+
+// RunHooks runs the given hook commands for the profile, stopping at the
+// first failure. Each command is run through the system shell with
+// TELEPORT_PROFILE and TELEPORT_HOME set in the environment.
+func RunHooks(p *Profile, commands []string) error {
+	for _, command := range commands {
+		var cmd *exec.Cmd
+		if runtime.GOOS == "windows" {
+			cmd = exec.Command("cmd", "/C", command)
+		} else {
+			cmd = exec.Command("sh", "-c", command)
+		}
+		cmd.Env = append(os.Environ(),
+			"TELEPORT_PROFILE="+p.Name(),
+			"TELEPORT_HOME="+p.Dir,
+		)
+		cmd.Stdout = os.Stdout
+		cmd.Stderr = os.Stderr
+		if err := cmd.Run(); err != nil {
+			return trace.Wrap(err, "profile hook %q failed", command)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2022 Gravitational, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package profile
+
+import (
+	"github.com/gravitational/teleport/api/utils/keypaths"
+)
+
+// KeyStore resolves the storage locations of a profile's key material.
+//
+// The default key store resolves into the "keys" subdirectory of the profile
+// directory. Alternative implementations may resolve into an OS keychain or
+// in-memory storage, allowing key material to be kept out of plaintext files.
+type KeyStore interface {
+	// UserKeyPath returns the path to the profile's private key.
+	UserKeyPath(p *Profile) string
+	// TLSCertPath returns the path to the profile's TLS certificate.
+	TLSCertPath(p *Profile) string
+	// SSHCertPath returns the path to the profile's SSH certificate.
+	SSHCertPath(p *Profile) string
+	// KnownHostsPath returns the path to the profile's SSH certificate
+	// authorities.
+	KnownHostsPath(p *Profile) string
+	// AppCertPath returns the path to the profile's certificate for a given
+	// application.
+	AppCertPath(p *Profile, appName string) string
+}
+
+// dirKeyStore is the default KeyStore. It resolves key material into the
+// "keys" subdirectory of the profile directory.
+type dirKeyStore struct{}
+
+// UserKeyPath returns the path to the profile's private key.
+func (dirKeyStore) UserKeyPath(p *Profile) string {
+	return keypaths.UserKeyPath(p.keysDir(), p.Name(), p.Username)
+}
+
+// TLSCertPath returns the path to the profile's TLS certificate.
+func (dirKeyStore) TLSCertPath(p *Profile) string {
+	return keypaths.TLSCertPath(p.keysDir(), p.Name(), p.Username)
+}
+
+// SSHCertPath returns the path to the profile's SSH certificate.
+func (dirKeyStore) SSHCertPath(p *Profile) string {
+	return keypaths.SSHCertPath(p.keysDir(), p.Name(), p.Username, p.SiteName)
+}
+
+// KnownHostsPath returns the path to the profile's SSH certificate authorities.
+func (dirKeyStore) KnownHostsPath(p *Profile) string {
+	return keypaths.KnownHostsPath(p.keysDir())
+}
+
+// AppCertPath returns the path to the profile's certificate for a given
+// application.
+func (dirKeyStore) AppCertPath(p *Profile, appName string) string {
+	return keypaths.AppCertPath(p.keysDir(), p.Name(), p.Username, p.SiteName, appName)
+}
+
+// SetKeyStore overrides the KeyStore used to resolve this profile's key
+// material paths. Passing nil restores the default directory-backed store.
+func (p *Profile) SetKeyStore(ks KeyStore) {
+	p.keyStore = ks
+}
+
+// getKeyStore returns the profile's KeyStore, falling back to the default
+// directory-backed store.
+func (p *Profile) getKeyStore() KeyStore {
+	if p.keyStore != nil {
+		return p.keyStore
+	}
+	return dirKeyStore{}
+}
@@ -26,6 +26,7 @@ import (
 	"os/user"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/gravitational/teleport/api/utils/keypaths"
 	"github.com/gravitational/teleport/api/utils/keys"
@@ -42,6 +43,10 @@ const (
 	// currentProfileFilename is a file which stores the name of the
 	// currently active profile.
 	currentProfileFilename = "current-profile"
+	// ProfileNameEnvVar is the environment variable that overrides the
+	// current profile for this process, so different terminals can pin
+	// different profiles without mutating the shared current-profile file.
+	ProfileNameEnvVar = "TELEPORT_PROFILE"
 )
 
 // Profile is a collection of most frequently used CLI flags
@@ -52,46 +57,109 @@ const (
 //
 type Profile struct {
 	// WebProxyAddr is the host:port the web proxy can be accessed at.
-	WebProxyAddr string `yaml:"web_proxy_addr,omitempty"`
+	WebProxyAddr string `yaml:"web_proxy_addr,omitempty" json:"web_proxy_addr,omitempty"`
 
 	// SSHProxyAddr is the host:port the SSH proxy can be accessed at.
-	SSHProxyAddr string `yaml:"ssh_proxy_addr,omitempty"`
+	SSHProxyAddr string `yaml:"ssh_proxy_addr,omitempty" json:"ssh_proxy_addr,omitempty"`
 
 	// KubeProxyAddr is the host:port the Kubernetes proxy can be accessed at.
-	KubeProxyAddr string `yaml:"kube_proxy_addr,omitempty"`
+	KubeProxyAddr string `yaml:"kube_proxy_addr,omitempty" json:"kube_proxy_addr,omitempty"`
 
 	// PostgresProxyAddr is the host:port the Postgres proxy can be accessed at.
-	PostgresProxyAddr string `yaml:"postgres_proxy_addr,omitempty"`
+	PostgresProxyAddr string `yaml:"postgres_proxy_addr,omitempty" json:"postgres_proxy_addr,omitempty"`
 
 	// MySQLProxyAddr is the host:port the MySQL proxy can be accessed at.
-	MySQLProxyAddr string `yaml:"mysql_proxy_addr,omitempty"`
+	MySQLProxyAddr string `yaml:"mysql_proxy_addr,omitempty" json:"mysql_proxy_addr,omitempty"`
 
 	// MongoProxyAddr is the host:port the Mongo proxy can be accessed at.
-	MongoProxyAddr string `yaml:"mongo_proxy_addr,omitempty"`
+	MongoProxyAddr string `yaml:"mongo_proxy_addr,omitempty" json:"mongo_proxy_addr,omitempty"`
 
 	// Username is the Teleport username for the client.
-	Username string `yaml:"user,omitempty"`
+	Username string `yaml:"user,omitempty" json:"user,omitempty"`
 
 	// SiteName is equivalent to the --cluster flag
-	SiteName string `yaml:"cluster,omitempty"`
+	SiteName string `yaml:"cluster,omitempty" json:"cluster,omitempty"`
 
 	// ForwardedPorts is the list of ports to forward to the target node.
-	ForwardedPorts []string `yaml:"forward_ports,omitempty"`
+	ForwardedPorts []string `yaml:"forward_ports,omitempty" json:"forward_ports,omitempty"`
 
 	// DynamicForwardedPorts is a list of ports to use for dynamic port
 	// forwarding (SOCKS5).
-	DynamicForwardedPorts []string `yaml:"dynamic_forward_ports,omitempty"`
+	DynamicForwardedPorts []string `yaml:"dynamic_forward_ports,omitempty" json:"dynamic_forward_ports,omitempty"`
 
 	// Dir is the directory of this profile.
-	Dir string
+	Dir string `json:"dir,omitempty"`
+
+	// KeysDir is an optional directory where this profile's keys and
+	// certificates are stored, e.g. an encrypted volume or tmpfs. All path
+	// helpers resolve into it. If empty, keys live under Dir.
+	KeysDir string `yaml:"keys_dir,omitempty" json:"keys_dir,omitempty"`
 
 	// TLSRoutingEnabled indicates that proxy supports ALPN SNI server where
 	// all proxy services are exposed on a single TLS listener (Proxy Web Listener).
-	TLSRoutingEnabled bool `yaml:"tls_routing_enabled,omitempty"`
+	TLSRoutingEnabled bool `yaml:"tls_routing_enabled,omitempty" json:"tls_routing_enabled,omitempty"`
+
+	// TLSRoutingConnUpgradeRequired indicates that ALPN connection upgrades
+	// are required for making TLS routing requests, for example when the
+	// proxy is behind a layer 7 load balancer that terminates TLS. The value
+	// is probed at login and persisted so subsequent commands don't have to
+	// re-probe the proxy.
+	TLSRoutingConnUpgradeRequired bool `yaml:"tls_routing_conn_upgrade_required,omitempty" json:"tls_routing_conn_upgrade_required,omitempty"`
+
+	// WebProxyInsecure indicates the web proxy's TLS certificate should not
+	// be verified, persisted from an insecure login.
+	WebProxyInsecure bool `yaml:"web_proxy_insecure,omitempty" json:"web_proxy_insecure,omitempty"`
+
+	// WebProxyCAPath is an optional path to a CA certificate used to verify
+	// the web proxy's TLS certificate.
+	WebProxyCAPath string `yaml:"web_proxy_ca_path,omitempty" json:"web_proxy_ca_path,omitempty"`
 
 	// AuthConnector (like "google", "passwordless").
 	// Equivalent to the --auth tsh flag.
-	AuthConnector string `yaml:"auth_connector,omitempty"`
+	AuthConnector string `yaml:"auth_connector,omitempty" json:"auth_connector,omitempty"`
+
+	// ProxyTemplates is a list of rules rewriting the proxy, cluster and
+	// user used to reach a host, see MatchHost.
+	ProxyTemplates []ProxyTemplate `yaml:"proxy_templates,omitempty" json:"proxy_templates,omitempty"`
+
+	// Hooks configures commands run on profile lifecycle events, see
+	// RunHooks.
+	Hooks *Hooks `yaml:"hooks,omitempty" json:"hooks,omitempty"`
+
+	// DefaultLogin is the default login used when connecting to hosts.
+	DefaultLogin string `yaml:"default_login,omitempty" json:"default_login,omitempty"`
+
+	// DefaultDBUser is the default database user, saving users from passing
+	// --db-user on every connect.
+	DefaultDBUser string `yaml:"default_db_user,omitempty" json:"default_db_user,omitempty"`
+
+	// DefaultDBName is the default database name, saving users from passing
+	// --db-name on every connect.
+	DefaultDBName string `yaml:"default_db_name,omitempty" json:"default_db_name,omitempty"`
+
+	// KubeNamespace is the default Kubernetes namespace.
+	KubeNamespace string `yaml:"kube_namespace,omitempty" json:"kube_namespace,omitempty"`
+
+	// KubeContext is the default Kubernetes context.
+	KubeContext string `yaml:"kube_context,omitempty" json:"kube_context,omitempty"`
+
+	// LoginTime is the time of the last successful login, stamped on save.
+	LoginTime time.Time `yaml:"login_time,omitempty" json:"login_time,omitempty"`
+
+	// ValidUntil is the expiry time of the certificates issued at login.
+	ValidUntil time.Time `yaml:"valid_until,omitempty" json:"valid_until,omitempty"`
+
+	// LastUsed is the time the profile was last used, updated by Touch.
+	LastUsed time.Time `yaml:"last_used,omitempty" json:"last_used,omitempty"`
+
+	// UseCount is the number of times the profile has been used, updated by
+	// Touch.
+	UseCount int `yaml:"use_count,omitempty" json:"use_count,omitempty"`
+
+	// keyStore resolves the storage locations of this profile's key
+	// material. Defaults to the "keys" subdirectory of the profile
+	// directory, see SetKeyStore.
+	keyStore KeyStore
 }
 
 // Name returns the name of the profile.
@@ -221,22 +289,78 @@ func SetCurrentProfileName(dir string, name string) error {
 	return nil
 }
 
-// RemoveProfile removes cluster profile file
+// RemoveProfile removes the cluster profile file along with the keys and
+// certificates stored for it. If the removed profile was current, the
+// current profile is unset.
 func RemoveProfile(dir, name string) error {
+	p, err := FromDir(dir, name)
+
+	// Run pre-logout hooks while the profile can still be read.
+	if err == nil && p.Hooks != nil {
+		if err := RunHooks(p, p.Hooks.PreLogout); err != nil {
+			return trace.Wrap(err)
+		}
+	}
+
 	profilePath := filepath.Join(dir, name+".yaml")
 	if err := os.Remove(profilePath); err != nil {
 		return trace.ConvertSystemError(err)
 	}
 
+	// Remove the keys and certificates issued for this profile's proxy. The
+	// loaded profile resolves the key directory honoring the KeysDir override;
+	// fall back to the default location when the profile could not be read.
+	proxyKeyDir := keypaths.ProxyKeyDir(dir, name)
+	if p != nil {
+		proxyKeyDir = p.ProxyKeyDir()
+	}
+	if err := os.RemoveAll(proxyKeyDir); err != nil {
+		return trace.ConvertSystemError(err)
+	}
+
+	// Unset the current profile if it pointed at the removed profile.
+	current, err := GetCurrentProfileName(dir)
+	if err != nil {
+		if trace.IsNotFound(err) {
+			return nil
+		}
+		return trace.Wrap(err)
+	}
+	if current == name {
+		if err := os.Remove(filepath.Join(dir, currentProfileFilename)); err != nil {
+			return trace.ConvertSystemError(err)
+		}
+	}
+
 	return nil
 }
 
-// GetCurrentProfileName attempts to load the current profile name.
+// Touch updates the profile's usage metadata: the last-used timestamp and
+// the usage counter. It is cheap enough to call on every use of the profile,
+// enabling tooling that cleans up or sorts profiles by recency.
+func Touch(dir, name string) error {
+	dir = FullProfilePath(dir)
+	p, err := FromDir(dir, name)
+	if err != nil {
+		return trace.Wrap(err)
+	}
+	p.LastUsed = time.Now().UTC()
+	p.UseCount++
+	return trace.Wrap(p.saveToFile(filepath.Join(dir, p.Name()+".yaml")))
+}
+
+// GetCurrentProfileName attempts to load the current profile name. The
+// ProfileNameEnvVar environment variable takes precedence over the shared
+// current-profile file.
 func GetCurrentProfileName(dir string) (name string, err error) {
 	if dir == "" {
 		return "", trace.BadParameter("cannot get current profile: missing dir")
 	}
 
+	if name := strings.TrimSpace(os.Getenv(ProfileNameEnvVar)); name != "" {
+		return name, nil
+	}
+
 	data, err := os.ReadFile(filepath.Join(dir, currentProfileFilename))
 	if err != nil {
 		if os.IsNotExist(err) {
@@ -318,10 +442,19 @@ func FromDir(dir string, name string) (*Profile, error) {
 
 // profileFromFile loads the profile from a YAML file.
 func profileFromFile(filePath string) (*Profile, error) {
+	if err := checkSymlink(filePath); err != nil {
+		return nil, trace.Wrap(err)
+	}
 	bytes, err := os.ReadFile(filePath)
 	if err != nil {
 		return nil, trace.ConvertSystemError(err)
 	}
+	// Transparently decrypt profiles encrypted at rest.
+	if IsEncrypted(bytes) {
+		if bytes, err = decryptProfile(bytes, getEncryptionPassphrase()); err != nil {
+			return nil, trace.Wrap(err)
+		}
+	}
 	var p *Profile
 	if err := yaml.Unmarshal(bytes, &p); err != nil {
 		return nil, trace.Wrap(err)
@@ -343,90 +476,179 @@ func (p *Profile) SaveToDir(dir string, makeCurrent bool) error {
 	if dir == "" {
 		return trace.BadParameter("cannot save profile: missing dir")
 	}
+	if err := checkProfileDir(dir); err != nil {
+		return trace.Wrap(err)
+	}
+	if p.LoginTime.IsZero() {
+		p.LoginTime = time.Now().UTC()
+	}
+	// Store the certificate expiry so tools can check profile status without
+	// parsing certificates, see Status.
+	if p.ValidUntil.IsZero() {
+		if expiry, ok := p.Expiry(); ok {
+			p.ValidUntil = expiry.UTC()
+		}
+	}
 	if err := p.saveToFile(filepath.Join(dir, p.Name()+".yaml")); err != nil {
 		return trace.Wrap(err)
 	}
 	if makeCurrent {
-		return trace.Wrap(SetCurrentProfileName(dir, p.Name()))
+		if err := SetCurrentProfileName(dir, p.Name()); err != nil {
+			return trace.Wrap(err)
+		}
+		if p.Hooks != nil {
+			return trace.Wrap(RunHooks(p, p.Hooks.PostLogin))
+		}
 	}
 	return nil
 }
 
-// saveToFile saves this profile to the specified file.
+// saveToFile saves this profile to the specified file. The profile is
+// encrypted at rest if an encryption passphrase is set.
 func (p *Profile) saveToFile(filepath string) error {
+	if err := checkSymlink(filepath); err != nil {
+		return trace.Wrap(err)
+	}
 	bytes, err := yaml.Marshal(&p)
 	if err != nil {
 		return trace.Wrap(err)
 	}
+	if passphrase := getEncryptionPassphrase(); len(passphrase) != 0 {
+		if bytes, err = encryptProfile(bytes, passphrase); err != nil {
+			return trace.Wrap(err)
+		}
+	}
 	if err = os.WriteFile(filepath, bytes, 0660); err != nil {
 		return trace.Wrap(err)
 	}
+	if err := hardenProfileFile(filepath); err != nil {
+		return trace.Wrap(err)
+	}
 	return nil
 }
 
+// checkSymlink returns a typed error if the given path is a symlink, to
+// protect profile reads and writes from symlink traversal.
+func checkSymlink(path string) error {
+	fi, err := os.Lstat(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return trace.ConvertSystemError(err)
+	}
+	if fi.Mode()&os.ModeSymlink != 0 {
+		return trace.AccessDenied("refusing to follow symlink %v", path)
+	}
+	return nil
+}
+
+// keysDir returns the directory the profile's keys are resolved into,
+// honoring the KeysDir override.
+func (p *Profile) keysDir() string {
+	if p.KeysDir != "" {
+		return p.KeysDir
+	}
+	return p.Dir
+}
+
 // KeyDir returns the path to the profile's directory.
 func (p *Profile) KeyDir() string {
-	return keypaths.KeyDir(p.Dir)
+	return keypaths.KeyDir(p.keysDir())
 }
 
 // ProxyKeyDir returns the path to the profile's key directory.
 func (p *Profile) ProxyKeyDir() string {
-	return keypaths.ProxyKeyDir(p.Dir, p.Name())
+	return keypaths.ProxyKeyDir(p.keysDir(), p.Name())
 }
 
 // UserKeyPath returns the path to the profile's private key.
 func (p *Profile) UserKeyPath() string {
-	return keypaths.UserKeyPath(p.Dir, p.Name(), p.Username)
+	return p.getKeyStore().UserKeyPath(p)
 }
 
 // TLSCertPath returns the path to the profile's TLS certificate.
 func (p *Profile) TLSCertPath() string {
-	return keypaths.TLSCertPath(p.Dir, p.Name(), p.Username)
+	return p.getKeyStore().TLSCertPath(p)
 }
 
 // TLSCAsLegacyPath returns the path to the profile's TLS certificate authorities.
 func (p *Profile) TLSCAsLegacyPath() string {
-	return keypaths.TLSCAsPath(p.Dir, p.Name())
+	return keypaths.TLSCAsPath(p.keysDir(), p.Name())
 }
 
 // TLSCAPathCluster returns CA for particular cluster.
 func (p *Profile) TLSCAPathCluster(cluster string) string {
-	return keypaths.TLSCAsPathCluster(p.Dir, p.Name(), cluster)
+	return keypaths.TLSCAsPathCluster(p.keysDir(), p.Name(), cluster)
 }
 
 // TLSClusterCASDir returns CAS directory where cluster CAs are stored.
 func (p *Profile) TLSClusterCASDir() string {
-	return keypaths.CAsDir(p.Dir, p.Name())
+	return keypaths.CAsDir(p.keysDir(), p.Name())
 }
 
 // TLSCAsPath returns the legacy path to the profile's TLS certificate authorities.
 func (p *Profile) TLSCAsPath() string {
-	return keypaths.TLSCAsPath(p.Dir, p.Name())
+	return keypaths.TLSCAsPath(p.keysDir(), p.Name())
 }
 
 // SSHDir returns the path to the profile's ssh directory.
 func (p *Profile) SSHDir() string {
-	return keypaths.SSHDir(p.Dir, p.Name(), p.Username)
+	return keypaths.SSHDir(p.keysDir(), p.Name(), p.Username)
 }
 
 // SSHCertPath returns the path to the profile's ssh certificate.
 func (p *Profile) SSHCertPath() string {
-	return keypaths.SSHCertPath(p.Dir, p.Name(), p.Username, p.SiteName)
+	return p.getKeyStore().SSHCertPath(p)
 }
 
 // PPKFilePath returns the path to the profile's PuTTY PPK-formatted keypair.
 func (p *Profile) PPKFilePath() string {
-	return keypaths.PPKFilePath(p.Dir, p.Name(), p.Username)
+	return keypaths.PPKFilePath(p.keysDir(), p.Name(), p.Username)
 }
 
 // KnownHostsPath returns the path to the profile's ssh certificate authorities.
 func (p *Profile) KnownHostsPath() string {
-	return keypaths.KnownHostsPath(p.Dir)
+	return p.getKeyStore().KnownHostsPath(p)
 }
 
 // AppCertPath returns the path to the profile's certificate for a given
 // application. Note that this function merely constructs the path - there
 // is no guarantee that there is an actual certificate at that location.
 func (p *Profile) AppCertPath(appName string) string {
-	return keypaths.AppCertPath(p.Dir, p.Name(), p.Username, p.SiteName, appName)
+	return p.getKeyStore().AppCertPath(p, appName)
+}
+
+// DatabaseCertPath returns the path to the profile's certificate for a given
+// database in the profile's cluster.
+func (p *Profile) DatabaseCertPath(dbName string) string {
+	return p.DatabaseCertPathForCluster(p.SiteName, dbName)
+}
+
+// DatabaseCertPathForCluster returns the path to the profile's certificate
+// for a given database in the given cluster. If cluster is empty, the
+// profile's cluster is used.
+func (p *Profile) DatabaseCertPathForCluster(cluster, dbName string) string {
+	if cluster == "" {
+		cluster = p.SiteName
+	}
+	return keypaths.DatabaseCertPath(p.keysDir(), p.Name(), p.Username, cluster, dbName)
+}
+
+// KubeCertPath returns the path to the profile's certificate for a given
+// Kubernetes cluster.
+func (p *Profile) KubeCertPath(kubeName string) string {
+	return keypaths.KubeCertPath(p.keysDir(), p.Name(), p.Username, p.SiteName, kubeName)
+}
+
+// KubeConfigPath returns the path to the profile's standalone kubeconfig for
+// a given Kubernetes cluster.
+func (p *Profile) KubeConfigPath(kubeName string) string {
+	return keypaths.KubeConfigPath(p.keysDir(), p.Name(), p.Username, p.SiteName, kubeName)
+}
+
+// WindowsDesktopCertPath returns the path to the profile's certificate for a
+// given Windows desktop.
+func (p *Profile) WindowsDesktopCertPath(desktopName string) string {
+	return keypaths.WindowsDesktopCertPath(p.keysDir(), p.Name(), p.Username, p.SiteName, desktopName)
 }
@@ -18,6 +18,8 @@ limitations under the License.
 package profile_test
 
 import (
+	"bytes"
+	"encoding/json"
 	"os"
 	"path/filepath"
 	"testing"
@@ -44,6 +46,9 @@ func TestProfileBasics(t *testing.T) {
 		Dir:                   dir,
 		SiteName:              "example.com",
 		AuthConnector:         "passwordless",
+
+		TLSRoutingEnabled:             true,
+		TLSRoutingConnUpgradeRequired: true,
 	}
 
 	// verify that profile name is proxy host component
@@ -85,6 +90,166 @@ func TestProfileBasics(t *testing.T) {
 	require.Equal(t, *p, *clone)
 }
 
+// TestProfileEncryption verifies that profiles are encrypted at rest when a
+// passphrase is set and are transparently decrypted on load.
+func TestProfileEncryption(t *testing.T) {
+	dir := t.TempDir()
+
+	profile.SetEncryptionPassphrase([]byte("hunter2"))
+	t.Cleanup(func() { profile.SetEncryptionPassphrase(nil) })
+
+	p := &profile.Profile{
+		WebProxyAddr: "proxy:3088",
+		Username:     "testuser",
+		Dir:          dir,
+		SiteName:     "example.com",
+	}
+	require.NoError(t, p.SaveToDir(dir, false))
+
+	// The file on disk must not contain plaintext YAML.
+	data, err := os.ReadFile(filepath.Join(dir, p.Name()+".yaml"))
+	require.NoError(t, err)
+	require.True(t, profile.IsEncrypted(data))
+	require.NotContains(t, string(data), "testuser")
+
+	// Loading with the correct passphrase transparently decrypts.
+	clone, err := profile.FromDir(dir, p.Name())
+	require.NoError(t, err)
+	require.Equal(t, *p, *clone)
+
+	// Loading with the wrong passphrase fails.
+	profile.SetEncryptionPassphrase([]byte("wrong"))
+	_, err = profile.FromDir(dir, p.Name())
+	require.Error(t, err)
+
+	// Loading without a passphrase fails with an actionable error.
+	profile.SetEncryptionPassphrase(nil)
+	_, err = profile.FromDir(dir, p.Name())
+	require.Error(t, err)
+}
+
+// TestListProfiles verifies that profiles are listed with their metadata.
+func TestListProfiles(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	first := &profile.Profile{
+		WebProxyAddr: "first.example.com:3080",
+		Username:     "testuser",
+		Dir:          dir,
+	}
+	require.NoError(t, first.SaveToDir(dir, false))
+
+	second := &profile.Profile{
+		WebProxyAddr: "second.example.com:3080",
+		Username:     "testuser",
+		Dir:          dir,
+	}
+	require.NoError(t, second.SaveToDir(dir, true))
+
+	infos, err := profile.ListProfiles(dir)
+	require.NoError(t, err)
+	require.Len(t, infos, 2)
+
+	byName := make(map[string]profile.ProfileInfo)
+	for _, info := range infos {
+		byName[info.Name] = info
+	}
+
+	require.Equal(t, "first.example.com:3080", byName["first.example.com"].ProxyAddr)
+	require.False(t, byName["first.example.com"].IsCurrent)
+	require.True(t, byName["second.example.com"].IsCurrent)
+	// No certificates were written, so the profiles read as expired.
+	require.Equal(t, profile.StatusExpired, byName["first.example.com"].Status)
+}
+
+// TestRemoveProfile verifies that removing a profile also removes its keys
+// and unsets the current profile.
+func TestRemoveProfile(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	p := &profile.Profile{
+		WebProxyAddr: "proxy:3088",
+		Username:     "testuser",
+		Dir:          dir,
+		SiteName:     "example.com",
+	}
+	require.NoError(t, p.SaveToDir(dir, true))
+
+	// Write some key material for the profile.
+	require.NoError(t, os.MkdirAll(p.ProxyKeyDir(), 0700))
+	require.NoError(t, os.WriteFile(p.UserKeyPath(), []byte("key"), 0600))
+
+	require.NoError(t, profile.RemoveProfile(dir, p.Name()))
+
+	// The profile file, its keys and the current profile must be gone.
+	_, err := os.Stat(filepath.Join(dir, p.Name()+".yaml"))
+	require.True(t, os.IsNotExist(err))
+	_, err = os.Stat(p.ProxyKeyDir())
+	require.True(t, os.IsNotExist(err))
+	_, err = profile.GetCurrentProfileName(dir)
+	require.True(t, trace.IsNotFound(err))
+}
+
+// TestRemoveProfileWithKeysDirOverride verifies that removing a profile deletes
+// the keys from the KeysDir override rather than the default location.
+func TestRemoveProfileWithKeysDirOverride(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	keysDir := t.TempDir()
+
+	p := &profile.Profile{
+		WebProxyAddr: "proxy:3088",
+		Username:     "testuser",
+		Dir:          dir,
+		KeysDir:      keysDir,
+		SiteName:     "example.com",
+	}
+	require.NoError(t, p.SaveToDir(dir, false))
+
+	// Write some key material into the override directory.
+	require.NoError(t, os.MkdirAll(p.ProxyKeyDir(), 0700))
+	require.NoError(t, os.WriteFile(p.UserKeyPath(), []byte("key"), 0600))
+
+	require.NoError(t, profile.RemoveProfile(dir, p.Name()))
+
+	_, err := os.Stat(p.ProxyKeyDir())
+	require.True(t, os.IsNotExist(err))
+}
+
+// TestProfileSerialization verifies JSON and io.Reader/Writer round trips.
+func TestProfileSerialization(t *testing.T) {
+	t.Parallel()
+
+	p := &profile.Profile{
+		WebProxyAddr: "proxy:3088",
+		Username:     "testuser",
+		SiteName:     "example.com",
+	}
+
+	// JSON round trip.
+	data, err := json.Marshal(p)
+	require.NoError(t, err)
+	require.Contains(t, string(data), `"web_proxy_addr":"proxy:3088"`)
+
+	var fromJSON profile.Profile
+	require.NoError(t, json.Unmarshal(data, &fromJSON))
+	require.Equal(t, *p, fromJSON)
+
+	// YAML writer/reader round trip.
+	var buf bytes.Buffer
+	_, err = p.WriteTo(&buf)
+	require.NoError(t, err)
+
+	clone, err := profile.FromReader(&buf)
+	require.NoError(t, err)
+	require.Equal(t, *p, *clone)
+}
+
 func TestAppPath(t *testing.T) {
 	t.Parallel()
 
@@ -101,3 +266,170 @@ func TestAppPath(t *testing.T) {
 	expected := filepath.Join(dir, "keys", "proxy", "testuser-app", "example.com", "banana-x509.pem")
 	require.Equal(t, expected, p.AppCertPath("banana"))
 }
+
+// TestCurrentProfileNameEnvVar verifies that the environment variable
+// override takes precedence over the current-profile file.
+func TestCurrentProfileNameEnvVar(t *testing.T) {
+	dir := t.TempDir()
+
+	require.NoError(t, profile.SetCurrentProfileName(dir, "shared"))
+
+	t.Setenv(profile.ProfileNameEnvVar, "pinned")
+	name, err := profile.GetCurrentProfileName(dir)
+	require.NoError(t, err)
+	require.Equal(t, "pinned", name)
+
+	t.Setenv(profile.ProfileNameEnvVar, "")
+	name, err = profile.GetCurrentProfileName(dir)
+	require.NoError(t, err)
+	require.Equal(t, "shared", name)
+}
+
+// TestValidate verifies that profile validation reports actionable issues.
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	p := &profile.Profile{
+		WebProxyAddr: "not-an-address",
+		Dir:          dir,
+	}
+
+	issues := p.Validate()
+	require.NotEmpty(t, issues)
+
+	fields := make(map[string]bool)
+	for _, issue := range issues {
+		require.NotEmpty(t, issue.Message)
+		fields[issue.Field] = true
+	}
+	require.True(t, fields["web_proxy_addr"])
+	require.True(t, fields["user"])
+	require.True(t, fields["key"])
+}
+
+// TestTouch verifies that usage metadata is updated on Touch.
+func TestTouch(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	p := &profile.Profile{
+		WebProxyAddr: "proxy:3088",
+		Username:     "testuser",
+		Dir:          dir,
+	}
+	require.NoError(t, p.SaveToDir(dir, false))
+
+	require.NoError(t, profile.Touch(dir, p.Name()))
+	require.NoError(t, profile.Touch(dir, p.Name()))
+
+	clone, err := profile.FromDir(dir, p.Name())
+	require.NoError(t, err)
+	require.Equal(t, 2, clone.UseCount)
+	require.False(t, clone.LastUsed.IsZero())
+}
+
+// TestBundleRoundTrip verifies that profiles and keys survive an export and
+// import cycle.
+func TestBundleRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	srcDir := t.TempDir()
+
+	p := &profile.Profile{
+		WebProxyAddr: "proxy:3088",
+		Username:     "testuser",
+		Dir:          srcDir,
+		SiteName:     "example.com",
+	}
+	require.NoError(t, p.SaveToDir(srcDir, true))
+	require.NoError(t, os.MkdirAll(p.ProxyKeyDir(), 0700))
+	require.NoError(t, os.WriteFile(p.UserKeyPath(), []byte("key"), 0600))
+
+	var buf bytes.Buffer
+	require.NoError(t, profile.ExportBundle(srcDir, []string{p.Name()}, &buf))
+
+	dstDir := t.TempDir()
+	require.NoError(t, profile.ImportBundle(dstDir, &buf))
+
+	clone, err := profile.FromDir(dstDir, p.Name())
+	require.NoError(t, err)
+	require.Equal(t, p.WebProxyAddr, clone.WebProxyAddr)
+
+	key, err := os.ReadFile(clone.UserKeyPath())
+	require.NoError(t, err)
+	require.Equal(t, []byte("key"), key)
+}
+
+// TestImportBundleSanitizes verifies that importing a bundle does not honor
+// the file modes recorded in the archive and strips hooks from the imported
+// profile.
+func TestImportBundleSanitizes(t *testing.T) {
+	t.Parallel()
+
+	srcDir := t.TempDir()
+
+	p := &profile.Profile{
+		WebProxyAddr: "proxy:3088",
+		Username:     "testuser",
+		Dir:          srcDir,
+		SiteName:     "example.com",
+		Hooks: &profile.Hooks{
+			PostLogin: []string{"true"},
+		},
+	}
+	require.NoError(t, p.SaveToDir(srcDir, true))
+	require.NoError(t, os.MkdirAll(p.ProxyKeyDir(), 0700))
+	// The executable bit must not survive the import.
+	require.NoError(t, os.WriteFile(p.UserKeyPath(), []byte("key"), 0755))
+
+	var buf bytes.Buffer
+	require.NoError(t, profile.ExportBundle(srcDir, []string{p.Name()}, &buf))
+
+	dstDir := t.TempDir()
+	require.NoError(t, profile.ImportBundle(dstDir, &buf))
+
+	clone, err := profile.FromDir(dstDir, p.Name())
+	require.NoError(t, err)
+	require.Nil(t, clone.Hooks)
+
+	info, err := os.Stat(clone.UserKeyPath())
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0600), info.Mode().Perm())
+}
+
+// TestProtocolPaths verifies the per-protocol path helpers follow the tsh
+// directory layout.
+func TestProtocolPaths(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	p := &profile.Profile{
+		WebProxyAddr: "proxy:3088",
+		Username:     "testuser",
+		Dir:          dir,
+		SiteName:     "example.com",
+	}
+
+	require.Equal(t,
+		filepath.Join(dir, "keys", "proxy", "testuser-db", "example.com", "postgres-x509.pem"),
+		p.DatabaseCertPath("postgres"))
+	require.Equal(t,
+		filepath.Join(dir, "keys", "proxy", "testuser-db", "leaf", "postgres-x509.pem"),
+		p.DatabaseCertPathForCluster("leaf", "postgres"))
+	require.Equal(t,
+		filepath.Join(dir, "keys", "proxy", "testuser-kube", "example.com", "kubeA-x509.pem"),
+		p.KubeCertPath("kubeA"))
+	require.Equal(t,
+		filepath.Join(dir, "keys", "proxy", "testuser-kube", "example.com", "kubeA-kubeconfig"),
+		p.KubeConfigPath("kubeA"))
+	require.Equal(t,
+		filepath.Join(dir, "keys", "proxy", "testuser-desktop", "example.com", "desktopA-x509.pem"),
+		p.WindowsDesktopCertPath("desktopA"))
+	require.Equal(t,
+		filepath.Join(dir, "keys", "proxy", "testuser.ppk"),
+		p.PPKFilePath())
+}
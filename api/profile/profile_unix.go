@@ -0,0 +1,45 @@
+//go:build !windows
+// +build !windows
+
+/*
+Copyright 2022 Gravitational, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package profile
+
+import (
+	"os"
+
+	"github.com/gravitational/trace"
+)
+
+// checkProfileDir returns a typed error if the profile directory permissions
+// allow other users to tamper with profiles.
+func checkProfileDir(dir string) error {
+	fi, err := os.Stat(dir)
+	if err != nil {
+		return trace.ConvertSystemError(err)
+	}
+	if fi.Mode().Perm()&0002 != 0 {
+		return trace.AccessDenied("profile directory %v is world-writable, run 'chmod o-w %v' to fix it", dir, dir)
+	}
+	return nil
+}
+
+// hardenProfileFile is a no-op on Unix, where the restrictive permissions are
+// set when the file is written.
+func hardenProfileFile(path string) error {
+	return nil
+}
@@ -0,0 +1,78 @@
+/*
+Copyright 2022 Gravitational, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package profile
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/gravitational/trace"
+	"gopkg.in/yaml.v2"
+)
+
+// profileJSON is an alias of Profile used to avoid infinite recursion in the
+// JSON marshalling methods.
+type profileJSON Profile
+
+// MarshalJSON returns the profile as JSON, so it can be embedded in other
+// config systems.
+func (p *Profile) MarshalJSON() ([]byte, error) {
+	data, err := json.Marshal((*profileJSON)(p))
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+	return data, nil
+}
+
+// UnmarshalJSON loads the profile from JSON.
+func (p *Profile) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, (*profileJSON)(p)); err != nil {
+		return trace.Wrap(err)
+	}
+	return nil
+}
+
+// FromReader reads a YAML encoded profile from the given reader, so profiles
+// can be round-tripped without touching the filesystem.
+func FromReader(r io.Reader) (*Profile, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+	var p *Profile
+	if err := yaml.Unmarshal(data, &p); err != nil {
+		return nil, trace.Wrap(err)
+	}
+	if p == nil {
+		return nil, trace.BadParameter("empty profile")
+	}
+	return p, nil
+}
+
+// WriteTo writes the YAML encoded profile to the given writer, implementing
+// io.WriterTo.
+func (p *Profile) WriteTo(w io.Writer) (int64, error) {
+	data, err := yaml.Marshal(&p)
+	if err != nil {
+		return 0, trace.Wrap(err)
+	}
+	n, err := w.Write(data)
+	if err != nil {
+		return int64(n), trace.Wrap(err)
+	}
+	return int64(n), nil
+}
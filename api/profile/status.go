@@ -0,0 +1,144 @@
+/*
+Copyright 2022 Gravitational, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package profile
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"time"
+
+	"github.com/gravitational/trace"
+)
+
+// ProfileStatus describes the validity of a profile's certificates.
+type ProfileStatus string
+
+const (
+	// StatusActive indicates the profile's certificates are valid.
+	StatusActive ProfileStatus = "active"
+	// StatusAboutToExpire indicates the profile's certificates expire
+	// within AboutToExpireWindow.
+	StatusAboutToExpire ProfileStatus = "about-to-expire"
+	// StatusExpired indicates the profile's certificates have expired and
+	// a re-login is needed.
+	StatusExpired ProfileStatus = "expired"
+)
+
+// AboutToExpireWindow is how close to expiry a profile's certificates must be
+// for the profile to be reported as about-to-expire.
+const AboutToExpireWindow = 10 * time.Minute
+
+// ProfileInfo describes a stored profile, for tools that render a profile
+// switcher without re-implementing directory scanning.
+type ProfileInfo struct {
+	// Name is the name of the profile.
+	Name string
+	// ProxyAddr is the host:port of the web proxy the profile points at.
+	ProxyAddr string
+	// IsCurrent is true if this is the currently active profile.
+	IsCurrent bool
+	// Status reports the validity of the profile's certificates.
+	Status ProfileStatus
+	// LastUsed is the time the profile was last used, see Touch.
+	LastUsed time.Time
+}
+
+// ListProfiles returns all profiles stored in the given directory along with
+// their status and proxy address. If dir is empty, the default profile
+// directory is used.
+func ListProfiles(dir string) ([]ProfileInfo, error) {
+	dir = FullProfilePath(dir)
+	names, err := ListProfileNames(dir)
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	// The current profile may legitimately be unset.
+	current, err := GetCurrentProfileName(dir)
+	if err != nil && !trace.IsNotFound(err) {
+		return nil, trace.Wrap(err)
+	}
+
+	var infos []ProfileInfo
+	for _, name := range names {
+		p, err := FromDir(dir, name)
+		if err != nil {
+			return nil, trace.Wrap(err)
+		}
+		infos = append(infos, ProfileInfo{
+			Name:      name,
+			ProxyAddr: p.WebProxyAddr,
+			IsCurrent: name == current,
+			Status:    p.Status(),
+			LastUsed:  p.LastUsed,
+		})
+	}
+	return infos, nil
+}
+
+// Status loads the named profile from the given directory and reports whether
+// its certificates are active, about to expire, or expired. It relies on the
+// expiry stored in the profile on save, falling back to parsing the profile's
+// TLS certificate, so callers can decide whether a re-login is needed without
+// parsing certificates themselves.
+func Status(dir, name string) (ProfileStatus, error) {
+	p, err := FromDir(dir, name)
+	if err != nil {
+		return "", trace.Wrap(err)
+	}
+	return p.Status(), nil
+}
+
+// Status reports whether the profile's certificates are active, about to
+// expire, or expired.
+func (p *Profile) Status() ProfileStatus {
+	expiry, ok := p.Expiry()
+	if !ok {
+		return StatusExpired
+	}
+	switch left := time.Until(expiry); {
+	case left <= 0:
+		return StatusExpired
+	case left <= AboutToExpireWindow:
+		return StatusAboutToExpire
+	default:
+		return StatusActive
+	}
+}
+
+// Expiry returns the expiry time of the profile's certificates. It prefers
+// the expiry stored in the profile, falling back to parsing the profile's TLS
+// certificate. The boolean is false if neither is available.
+func (p *Profile) Expiry() (time.Time, bool) {
+	if !p.ValidUntil.IsZero() {
+		return p.ValidUntil, true
+	}
+	certPEM, err := os.ReadFile(p.TLSCertPath())
+	if err != nil {
+		return time.Time{}, false
+	}
+	block, _ := pem.Decode(certPEM)
+	if block == nil {
+		return time.Time{}, false
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return cert.NotAfter, true
+}
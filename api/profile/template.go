@@ -0,0 +1,90 @@
+/*
+Copyright 2022 Gravitational, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package profile
+
+import (
+	"regexp"
+
+	"github.com/gravitational/trace"
+)
+
+// ProxyTemplate is a rule rewriting the proxy, cluster and user used to reach
+// a host, similar to OpenSSH config Host blocks. Rules are carried in the
+// profile so they can be shared by tsh, tbot and Connect.
+type ProxyTemplate struct {
+	// Template is a regular expression matched against the full host name.
+	Template string `yaml:"template" json:"template"`
+	// Proxy is the proxy address to use for matching hosts. It may reference
+	// capture groups of Template, e.g. "$1.example.com:3080".
+	Proxy string `yaml:"proxy,omitempty" json:"proxy,omitempty"`
+	// Cluster is the cluster to route matching hosts to. It may reference
+	// capture groups of Template.
+	Cluster string `yaml:"cluster,omitempty" json:"cluster,omitempty"`
+	// User is the login to use on matching hosts. It may reference capture
+	// groups of Template.
+	User string `yaml:"user,omitempty" json:"user,omitempty"`
+}
+
+// Check validates the proxy template.
+func (t *ProxyTemplate) Check() error {
+	if t.Template == "" {
+		return trace.BadParameter("proxy template is missing the template expression")
+	}
+	if _, err := regexp.Compile(t.Template); err != nil {
+		return trace.BadParameter("invalid proxy template expression %q: %v", t.Template, err)
+	}
+	if t.Proxy == "" && t.Cluster == "" && t.User == "" {
+		return trace.BadParameter("proxy template %q needs at least one of proxy, cluster or user", t.Template)
+	}
+	return nil
+}
+
+// TemplateMatch describes the rewrites produced by a matching proxy template.
+// Empty fields are left unchanged by the template.
+type TemplateMatch struct {
+	// Proxy is the rewritten proxy address.
+	Proxy string
+	// Cluster is the rewritten cluster name.
+	Cluster string
+	// User is the rewritten login.
+	User string
+}
+
+// MatchHost applies the profile's proxy templates to the given host and
+// returns the resulting rewrites. The first matching template wins. The
+// boolean is false if no template matched.
+func (p *Profile) MatchHost(host string) (TemplateMatch, bool) {
+	for _, tpl := range p.ProxyTemplates {
+		re, err := regexp.Compile(tpl.Template)
+		if err != nil {
+			continue
+		}
+		match := re.FindStringSubmatchIndex(host)
+		if match == nil {
+			continue
+		}
+		expand := func(s string) string {
+			return string(re.ExpandString(nil, s, host, match))
+		}
+		return TemplateMatch{
+			Proxy:   expand(tpl.Proxy),
+			Cluster: expand(tpl.Cluster),
+			User:    expand(tpl.User),
+		}, true
+	}
+	return TemplateMatch{}, false
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2022 Gravitational, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package profile_test
+
+import (
+	"testing"
+
+	"github.com/gravitational/teleport/api/profile"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestMatchHost verifies proxy template host matching and rewrites.
+func TestMatchHost(t *testing.T) {
+	t.Parallel()
+
+	p := &profile.Profile{
+		WebProxyAddr: "proxy:3080",
+		ProxyTemplates: []profile.ProxyTemplate{
+			{
+				Template: `^(\w+)\.(leaf\.example\.com)$`,
+				Proxy:    "$2:3080",
+				Cluster:  "$2",
+			},
+			{
+				Template: `^.*\.root\.example\.com$`,
+				User:     "admin",
+			},
+		},
+	}
+
+	// First template matches and expands capture groups.
+	match, ok := p.MatchHost("node.leaf.example.com")
+	require.True(t, ok)
+	require.Equal(t, profile.TemplateMatch{
+		Proxy:   "leaf.example.com:3080",
+		Cluster: "leaf.example.com",
+	}, match)
+
+	// Second template matches without rewriting the proxy.
+	match, ok = p.MatchHost("node.root.example.com")
+	require.True(t, ok)
+	require.Equal(t, profile.TemplateMatch{User: "admin"}, match)
+
+	// No template matches.
+	_, ok = p.MatchHost("node.other.example.com")
+	require.False(t, ok)
+}
+
+// TestProxyTemplateCheck verifies proxy template validation.
+func TestProxyTemplateCheck(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		template profile.ProxyTemplate
+		wantErr  bool
+	}{
+		{
+			name:     "valid template",
+			template: profile.ProxyTemplate{Template: `^.*$`, Proxy: "proxy:3080"},
+		},
+		{
+			name:     "missing expression",
+			template: profile.ProxyTemplate{Proxy: "proxy:3080"},
+			wantErr:  true,
+		},
+		{
+			name:     "invalid expression",
+			template: profile.ProxyTemplate{Template: `(`, Proxy: "proxy:3080"},
+			wantErr:  true,
+		},
+		{
+			name:     "no rewrites",
+			template: profile.ProxyTemplate{Template: `^.*$`},
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.template.Check()
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2022 Gravitational, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package profile
+
+import (
+	"fmt"
+	"net"
+	"os"
+
+	"github.com/gravitational/teleport/api/utils/keys"
+	"github.com/gravitational/teleport/api/utils/sshutils"
+)
+
+// ValidationIssue describes a single problem found by Validate, so
+// "tsh status"-style commands can explain exactly what's broken.
+type ValidationIssue struct {
+	// Field is the profile field or file the issue relates to.
+	Field string
+	// Message is a human-readable description of the problem and, where
+	// possible, how to fix it.
+	Message string
+}
+
+// String returns a human-readable representation of the issue.
+func (i ValidationIssue) String() string {
+	return fmt.Sprintf("%v: %v", i.Field, i.Message)
+}
+
+// Validate checks that the profile's addresses parse, that the referenced
+// key and certificate files exist and match, and that the CA chain is
+// readable. It returns all issues found; an empty result means the profile
+// is usable as-is.
+func (p *Profile) Validate() []ValidationIssue {
+	var issues []ValidationIssue
+	addIssue := func(field, format string, args ...interface{}) {
+		issues = append(issues, ValidationIssue{Field: field, Message: fmt.Sprintf(format, args...)})
+	}
+
+	// Addresses must be set and parse as host:port.
+	if p.WebProxyAddr == "" {
+		addIssue("web_proxy_addr", "missing web proxy address, log in again with 'tsh login --proxy=<addr>'")
+	} else if _, _, err := net.SplitHostPort(p.WebProxyAddr); err != nil {
+		addIssue("web_proxy_addr", "invalid address %q: %v", p.WebProxyAddr, err)
+	}
+	for field, addr := range map[string]string{
+		"ssh_proxy_addr":      p.SSHProxyAddr,
+		"kube_proxy_addr":     p.KubeProxyAddr,
+		"postgres_proxy_addr": p.PostgresProxyAddr,
+		"mysql_proxy_addr":    p.MySQLProxyAddr,
+		"mongo_proxy_addr":    p.MongoProxyAddr,
+	} {
+		if addr == "" {
+			continue
+		}
+		if _, _, err := net.SplitHostPort(addr); err != nil {
+			addIssue(field, "invalid address %q: %v", addr, err)
+		}
+	}
+	if p.Username == "" {
+		addIssue("user", "missing user name")
+	}
+
+	// Proxy templates must compile.
+	for i, tpl := range p.ProxyTemplates {
+		if err := tpl.Check(); err != nil {
+			addIssue(fmt.Sprintf("proxy_templates[%d]", i), "%v", err)
+		}
+	}
+
+	// The private key and TLS certificate must exist and match.
+	keyExists := fileExists(p.UserKeyPath())
+	if !keyExists {
+		addIssue("key", "private key %v not found, log in again with 'tsh login'", p.UserKeyPath())
+	}
+	certExists := fileExists(p.TLSCertPath())
+	if !certExists {
+		addIssue("tls_cert", "TLS certificate %v not found, log in again with 'tsh login'", p.TLSCertPath())
+	}
+	if keyExists && certExists {
+		if _, err := keys.LoadX509KeyPair(p.TLSCertPath(), p.UserKeyPath()); err != nil {
+			addIssue("tls_cert", "TLS certificate and private key do not match: %v", err)
+		}
+	}
+
+	// The CA chain must be readable and parse.
+	if _, err := certPoolFromProfile(p); err != nil {
+		addIssue("tls_cas", "cannot load TLS certificate authorities: %v", err)
+	}
+
+	// The SSH certificate, if present, must parse.
+	if fileExists(p.SSHCertPath()) {
+		cert, err := os.ReadFile(p.SSHCertPath())
+		if err == nil {
+			if _, err := sshutils.ParseCertificate(cert); err != nil {
+				addIssue("ssh_cert", "invalid SSH certificate %v: %v", p.SSHCertPath(), err)
+			}
+		}
+	}
+	if !fileExists(p.KnownHostsPath()) {
+		addIssue("known_hosts", "known hosts file %v not found, SSH host verification will fail", p.KnownHostsPath())
+	}
+
+	return issues
+}
+
+// fileExists returns true if the given path exists and is a regular file.
+func fileExists(path string) bool {
+	fi, err := os.Stat(path)
+	return err == nil && fi.Mode().IsRegular()
+}
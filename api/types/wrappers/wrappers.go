@@ -90,6 +90,23 @@ func (l Traits) Size() int {
 	return l.protoType().Size()
 }
 
+// UnmarshalJSON unmarshals JSON traits, trait values accept both a scalar
+// string and a list of strings.
+func (l *Traits) UnmarshalJSON(data []byte) error {
+	if len(data) == 0 {
+		return nil
+	}
+	var values map[string]Strings
+	if err := json.Unmarshal(data, &values); err != nil {
+		return trace.Wrap(err)
+	}
+	*l = make(Traits, len(values))
+	for key, vals := range values {
+		(*l)[key] = vals
+	}
+	return nil
+}
+
 // Strings is a list of string that can unmarshal from list of strings
 // or a scalar string from scalar yaml or json property
 type Strings []string
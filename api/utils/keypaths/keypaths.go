@@ -50,6 +50,8 @@ const (
 	dbDirSuffix = "-db"
 	// kubeDirSuffix is the suffix of a sub-directory where kube TLS certs are stored.
 	kubeDirSuffix = "-kube"
+	// desktopDirSuffix is the suffix of a sub-directory where Windows desktop TLS certs are stored.
+	desktopDirSuffix = "-desktop"
 	// kubeConfigSuffix is the suffix of a kubeconfig file stored under the keys directory.
 	kubeConfigSuffix = "-kubeconfig"
 	// casDir is the directory name for where clusters certs are stored.
@@ -284,6 +286,30 @@ func KubeConfigPath(baseDir, proxy, username, cluster, kubename string) string {
 	return filepath.Join(KubeCertDir(baseDir, proxy, username, cluster), kubename+kubeConfigSuffix)
 }
 
+// WindowsDesktopDir returns the path to the user's Windows desktop directory
+// for the given proxy.
+//
+// <baseDir>/keys/<proxy>/<username>-desktop
+func WindowsDesktopDir(baseDir, proxy, username string) string {
+	return filepath.Join(ProxyKeyDir(baseDir, proxy), username+desktopDirSuffix)
+}
+
+// WindowsDesktopCertDir returns the path to the user's Windows desktop cert
+// directory for the given proxy and cluster.
+//
+// <baseDir>/keys/<proxy>/<username>-desktop/<cluster>
+func WindowsDesktopCertDir(baseDir, proxy, username, cluster string) string {
+	return filepath.Join(WindowsDesktopDir(baseDir, proxy, username), cluster)
+}
+
+// WindowsDesktopCertPath returns the path to the user's TLS certificate
+// for the given proxy, cluster, and Windows desktop.
+//
+// <baseDir>/keys/<proxy>/<username>-desktop/<cluster>/<desktopname>-x509.pem
+func WindowsDesktopCertPath(baseDir, proxy, username, cluster, desktopname string) string {
+	return filepath.Join(WindowsDesktopCertDir(baseDir, proxy, username, cluster), desktopname+fileExtTLSCert)
+}
+
 // IsProfileKubeConfigPath makes a best effort attempt to check if the given
 // path is a profile specific kubeconfig path generated by this package.
 func IsProfileKubeConfigPath(path string) (bool, error) {
@@ -75,6 +75,10 @@ type LoginOpts struct {
 	User string
 	// AuthenticatorAttachment specifies the desired authenticator attachment.
 	AuthenticatorAttachment AuthenticatorAttachment
+	// DevicePath is the path of the exact authenticator to use, as reported
+	// by device enumeration. If empty, all devices are considered.
+	// Implementations that cannot target a specific device ignore it.
+	DevicePath string
 }
 
 // Login performs client-side, U2F-compatible, Webauthn login.
@@ -0,0 +1,83 @@
+// Copyright 2022 Gravitational, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package webauthnwin implements client-side Webauthn logins backed by the
+// native Windows WebAuthn API (webauthn.dll). The native API lets Windows
+// Hello PIN/biometrics satisfy challenges, in addition to any security keys
+// managed by the OS, and drives its own UI for device selection and user
+// verification.
+package webauthnwin
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/gravitational/trace"
+
+	"github.com/gravitational/teleport/api/client/proto"
+	wanlib "github.com/gravitational/teleport/lib/auth/webauthn"
+)
+
+// IsAvailable returns true if the native Windows WebAuthn API is present on
+// this system. It always returns false outside of Windows.
+func IsAvailable() bool {
+	return isAvailable()
+}
+
+// Login performs a client-side Webauthn login using the native Windows
+// WebAuthn API. The API presents its own modal UI, so unlike FIDO2 logins no
+// prompt interface is required. It blocks until the user completes or
+// dismisses the system dialog.
+// It returns an MFAAuthenticateResponse and the credential user, if a
+// resident credential is used.
+func Login(ctx context.Context, origin string, assertion *wanlib.CredentialAssertion) (*proto.MFAAuthenticateResponse, string, error) {
+	switch {
+	case origin == "":
+		return nil, "", trace.BadParameter("origin required")
+	case assertion == nil:
+		return nil, "", trace.BadParameter("assertion required")
+	case len(assertion.Response.Challenge) == 0:
+		return nil, "", trace.BadParameter("assertion challenge required")
+	case assertion.Response.RelyingPartyID == "":
+		return nil, "", trace.BadParameter("assertion relying party ID required")
+	}
+
+	resp, err := login(ctx, origin, assertion)
+	if err != nil {
+		return nil, "", trace.Wrap(err)
+	}
+	return &proto.MFAAuthenticateResponse{
+		Response: &proto.MFAAuthenticateResponse_Webauthn{
+			Webauthn: wanlib.CredentialAssertionResponseToProto(resp),
+		},
+	}, "", nil
+}
+
+// collectedClientData is the CollectedClientData dictionary assembled on
+// behalf of webauthn.dll, which hashes it but does not build it.
+type collectedClientData struct {
+	Type      string `json:"type"`
+	Challenge string `json:"challenge"`
+	Origin    string `json:"origin"`
+}
+
+func assertionClientDataJSON(origin string, assertion *wanlib.CredentialAssertion) ([]byte, error) {
+	ccd, err := json.Marshal(&collectedClientData{
+		Type:      "webauthn.get",
+		Challenge: base64.RawURLEncoding.EncodeToString(assertion.Response.Challenge),
+		Origin:    origin,
+	})
+	return ccd, trace.Wrap(err)
+}
@@ -0,0 +1,259 @@
+// Copyright 2022 Gravitational, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webauthnwin
+
+import (
+	"context"
+	"encoding/base64"
+	"sync"
+	"time"
+	"unsafe"
+
+	"github.com/duo-labs/webauthn/protocol"
+	"github.com/gravitational/trace"
+	"golang.org/x/sys/windows"
+
+	wanlib "github.com/gravitational/teleport/lib/auth/webauthn"
+)
+
+var (
+	modWebAuthn = windows.NewLazySystemDLL("webauthn.dll")
+
+	procWebAuthNGetApiVersionNumber       = modWebAuthn.NewProc("WebAuthNGetApiVersionNumber")
+	procWebAuthNAuthenticatorGetAssertion = modWebAuthn.NewProc("WebAuthNAuthenticatorGetAssertion")
+	procWebAuthNFreeAssertion             = modWebAuthn.NewProc("WebAuthNFreeAssertion")
+
+	modUser32               = windows.NewLazySystemDLL("user32.dll")
+	procGetForegroundWindow = modUser32.NewProc("GetForegroundWindow")
+
+	availableOnce sync.Once
+	available     bool
+)
+
+// isAvailable loads webauthn.dll and checks the API version. Windows 10
+// 1903+ ships API version 1 or higher, which is all the assertion path below
+// requires.
+func isAvailable() bool {
+	availableOnce.Do(func() {
+		if err := modWebAuthn.Load(); err != nil {
+			return
+		}
+		if err := procWebAuthNGetApiVersionNumber.Find(); err != nil {
+			return
+		}
+		version, _, _ := procWebAuthNGetApiVersionNumber.Call()
+		available = version >= 1
+	})
+	return available
+}
+
+// Constants and structs below mirror webauthn.h.
+// https://github.com/microsoft/webauthn/blob/master/webauthn.h
+
+const (
+	webauthnClientDataCurrentVersion            = 1
+	webauthnCredentialCurrentVersion            = 1
+	webauthnAuthenticatorGetAssertionOptionsV1  = 1
+	webauthnAuthenticatorAttachmentAny          = 0
+	webauthnUserVerificationRequirementAny      = 0
+	webauthnUserVerificationRequirementRequired = 1
+)
+
+type webauthnClientData struct {
+	dwVersion        uint32
+	cbClientDataJSON uint32
+	pbClientDataJSON *byte
+	pwszHashAlgID    *uint16
+}
+
+type webauthnCredential struct {
+	dwVersion          uint32
+	cbID               uint32
+	pbID               *byte
+	pwszCredentialType *uint16
+}
+
+type webauthnCredentials struct {
+	cCredentials uint32
+	pCredentials *webauthnCredential
+}
+
+type webauthnExtensions struct {
+	cExtensions uint32
+	pExtensions uintptr
+}
+
+type webauthnAuthenticatorGetAssertionOptions struct {
+	dwVersion                     uint32
+	dwTimeoutMilliseconds         uint32
+	credentialList                webauthnCredentials
+	extensions                    webauthnExtensions
+	dwAuthenticatorAttachment     uint32
+	dwUserVerificationRequirement uint32
+	dwFlags                       uint32
+}
+
+type webauthnAssertion struct {
+	dwVersion           uint32
+	cbAuthenticatorData uint32
+	pbAuthenticatorData *byte
+	cbSignature         uint32
+	pbSignature         *byte
+	credential          webauthnCredential
+	cbUserID            uint32
+	pbUserID            *byte
+}
+
+func login(ctx context.Context, origin string, assertion *wanlib.CredentialAssertion) (*wanlib.CredentialAssertionResponse, error) {
+	ccdJSON, err := assertionClientDataJSON(origin, assertion)
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	hashAlg, err := windows.UTF16PtrFromString("SHA-256")
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+	clientData := webauthnClientData{
+		dwVersion:        webauthnClientDataCurrentVersion,
+		cbClientDataJSON: uint32(len(ccdJSON)),
+		pbClientDataJSON: &ccdJSON[0],
+		pwszHashAlgID:    hashAlg,
+	}
+
+	rpID, err := windows.UTF16PtrFromString(assertion.Response.RelyingPartyID)
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	credType, err := windows.UTF16PtrFromString("public-key")
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+	var allowList []webauthnCredential
+	for _, cred := range assertion.Response.AllowedCredentials {
+		credID := cred.CredentialID
+		if len(credID) == 0 {
+			continue
+		}
+		allowList = append(allowList, webauthnCredential{
+			dwVersion:          webauthnCredentialCurrentVersion,
+			cbID:               uint32(len(credID)),
+			pbID:               &credID[0],
+			pwszCredentialType: credType,
+		})
+	}
+
+	uv := uint32(webauthnUserVerificationRequirementAny)
+	if assertion.Response.UserVerification == protocol.VerificationRequired {
+		uv = webauthnUserVerificationRequirementRequired
+	}
+	opts := webauthnAuthenticatorGetAssertionOptions{
+		dwVersion:                     webauthnAuthenticatorGetAssertionOptionsV1,
+		dwAuthenticatorAttachment:     webauthnAuthenticatorAttachmentAny,
+		dwUserVerificationRequirement: uv,
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		if millis := time.Until(deadline).Milliseconds(); millis > 0 {
+			opts.dwTimeoutMilliseconds = uint32(millis)
+		}
+	}
+	if len(allowList) > 0 {
+		opts.credentialList = webauthnCredentials{
+			cCredentials: uint32(len(allowList)),
+			pCredentials: &allowList[0],
+		}
+	}
+
+	// The system dialog is attached to the calling window.
+	hwnd, _, _ := procGetForegroundWindow.Call()
+
+	var out *webauthnAssertion
+	ret, _, _ := procWebAuthNAuthenticatorGetAssertion.Call(
+		hwnd,
+		uintptr(unsafe.Pointer(rpID)),
+		uintptr(unsafe.Pointer(&clientData)),
+		uintptr(unsafe.Pointer(&opts)),
+		uintptr(unsafe.Pointer(&out)),
+	)
+	if ret != 0 { // S_OK
+		return nil, trace.Wrap(assertionError(ret))
+	}
+	defer procWebAuthNFreeAssertion.Call(uintptr(unsafe.Pointer(out)))
+
+	credID := copyBytes(out.credential.pbID, out.credential.cbID)
+	return &wanlib.CredentialAssertionResponse{
+		PublicKeyCredential: wanlib.PublicKeyCredential{
+			Credential: wanlib.Credential{
+				ID:   base64.RawURLEncoding.EncodeToString(credID),
+				Type: "public-key",
+			},
+			RawID: credID,
+		},
+		AssertionResponse: wanlib.AuthenticatorAssertionResponse{
+			AuthenticatorResponse: wanlib.AuthenticatorResponse{
+				ClientDataJSON: ccdJSON,
+			},
+			AuthenticatorData: copyBytes(out.pbAuthenticatorData, out.cbAuthenticatorData),
+			Signature:         copyBytes(out.pbSignature, out.cbSignature),
+			UserHandle:        copyBytes(out.pbUserID, out.cbUserID),
+		},
+	}, nil
+}
+
+// HRESULT values recognized by assertionError.
+const (
+	hresultNteExists                 = 0x8009000F // NTE_EXISTS
+	hresultErrorNotSupported         = 0x80070032 // HRESULT_FROM_WIN32(ERROR_NOT_SUPPORTED)
+	hresultNteNotSupported           = 0x80090029 // NTE_NOT_SUPPORTED
+	hresultNteTokenKeysetStorageFull = 0x80090023 // NTE_TOKEN_KEYSET_STORAGE_FULL
+	hresultNteInvalidParameter       = 0x80090027 // NTE_INVALID_PARAMETER
+	hresultNteDeviceNotFound         = 0x80090035 // NTE_DEVICE_NOT_FOUND
+	hresultNteNotFound               = 0x80090011 // NTE_NOT_FOUND
+	hresultErrorCancelled            = 0x800704C7 // HRESULT_FROM_WIN32(ERROR_CANCELLED)
+	hresultNteUserCancelled          = 0x80090036 // NTE_USER_CANCELLED
+	hresultErrorTimeout              = 0x800705B4 // HRESULT_FROM_WIN32(ERROR_TIMEOUT)
+)
+
+// assertionError translates an HRESULT into a readable error (eg,
+// "NotAllowedError" when the dialog is dismissed).
+// The mapping mirrors WebAuthNGetErrorName in webauthn.h. The export is not
+// called because its PCWSTR return value cannot be converted back to a
+// pointer without violating the unsafe.Pointer rules enforced by go vet.
+func assertionError(hresult uintptr) error {
+	var name string
+	switch uint32(hresult) {
+	case hresultNteExists:
+		name = "InvalidStateError"
+	case hresultErrorNotSupported, hresultNteNotSupported, hresultNteTokenKeysetStorageFull:
+		name = "ConstraintError"
+	case hresultNteInvalidParameter:
+		name = "NotSupportedError"
+	case hresultNteDeviceNotFound, hresultNteNotFound, hresultErrorCancelled, hresultNteUserCancelled, hresultErrorTimeout:
+		name = "NotAllowedError"
+	default:
+		return trace.Errorf("webauthn.dll assertion failed: HRESULT 0x%x", hresult)
+	}
+	return trace.Errorf("webauthn.dll assertion failed: %v", name)
+}
+
+func copyBytes(ptr *byte, size uint32) []byte {
+	if ptr == nil || size == 0 {
+		return nil
+	}
+	out := make([]byte, size)
+	copy(out, unsafe.Slice(ptr, size))
+	return out
+}
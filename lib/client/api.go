@@ -386,9 +386,9 @@ type Config struct {
 	ExtraProxyHeaders map[string]string
 
 	// AllowStdinHijack allows stdin hijack during MFA prompts.
-	// Stdin hijack provides a better login UX, but it can be difficult to reason
-	// about and is often a source of bugs.
-	// Do not set this options unless you deeply understand what you are doing.
+	//
+	// Deprecated: concurrent prompts multiplex the shared stdin reader with
+	// cancellation, so the option is no longer needed and is ignored.
 	AllowStdinHijack bool
 
 	// Tracer is the tracer to create spans with
@@ -662,6 +662,21 @@ func (p *ProfileStatus) AppLocalCAPath(name string) string {
 	return keypaths.AppLocalCAPath(p.Dir, p.Name, p.Username, p.Cluster, name)
 }
 
+// KubeCertPathForCluster returns path to the specified kube access certificate
+// for this profile, for the specified cluster.
+//
+// It's kept in <profile-dir>/keys/<proxy>/<user>-kube/<cluster>/<name>-x509.pem
+//
+// If the input cluster name is an empty string, the selected cluster in the
+// profile will be used.
+func (p *ProfileStatus) KubeCertPathForCluster(clusterName string, kubeName string) string {
+	if clusterName == "" {
+		clusterName = p.Cluster
+	}
+
+	return keypaths.KubeCertPath(p.Dir, p.Name, p.Username, clusterName, kubeName)
+}
+
 // KubeConfigPath returns path to the specified kubeconfig for this profile.
 //
 // It's kept in <profile-dir>/keys/<proxy>/<user>-kube/<cluster>/<name>-kubeconfig
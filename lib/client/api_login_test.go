@@ -138,34 +138,30 @@ func TestTeleportClient_Login_local(t *testing.T) {
 
 	ctx := context.Background()
 	tests := []struct {
-		name             string
-		secondFactor     constants.SecondFactorType
-		inputReader      *prompt.FakeReader
-		solveWebauthn    func(ctx context.Context, origin string, assertion *wanlib.CredentialAssertion, prompt wancli.LoginPrompt) (*proto.MFAAuthenticateResponse, error)
-		authConnector    string
-		allowStdinHijack bool
-		preferOTP        bool
+		name          string
+		secondFactor  constants.SecondFactorType
+		inputReader   *prompt.FakeReader
+		solveWebauthn func(ctx context.Context, origin string, assertion *wanlib.CredentialAssertion, prompt wancli.LoginPrompt) (*proto.MFAAuthenticateResponse, error)
+		authConnector string
+		preferOTP     bool
 	}{
 		{
-			name:             "OTP device login with hijack",
-			secondFactor:     constants.SecondFactorOptional,
-			inputReader:      prompt.NewFakeReader().AddString(password).AddReply(solveOTP),
-			solveWebauthn:    noopWebauthnFn,
-			allowStdinHijack: true,
+			name:          "OTP device login",
+			secondFactor:  constants.SecondFactorOptional,
+			inputReader:   prompt.NewFakeReader().AddString(password).AddReply(solveOTP),
+			solveWebauthn: noopWebauthnFn,
 		},
 		{
-			name:             "Webauthn device login with hijack",
-			secondFactor:     constants.SecondFactorOptional,
-			inputReader:      prompt.NewFakeReader().AddString(password).AddReply(waitForCancelFn),
-			solveWebauthn:    solveWebauthn,
-			allowStdinHijack: true,
+			name:          "Webauthn device login",
+			secondFactor:  constants.SecondFactorOptional,
+			inputReader:   prompt.NewFakeReader().AddString(password).AddReply(waitForCancelFn),
+			solveWebauthn: solveWebauthn,
 		},
 		{
-			name:             "Webauthn device with PIN and hijack", // a bit hypothetical, but _could_ happen.
-			secondFactor:     constants.SecondFactorOptional,
-			inputReader:      prompt.NewFakeReader().AddString(password).AddReply(waitForCancelFn).AddReply(userPINFn),
-			solveWebauthn:    solvePIN,
-			allowStdinHijack: true,
+			name:          "Webauthn device with PIN", // a bit hypothetical, but _could_ happen.
+			secondFactor:  constants.SecondFactorOptional,
+			inputReader:   prompt.NewFakeReader().AddString(password).AddReply(waitForCancelFn).AddReply(userPINFn),
+			solveWebauthn: solvePIN,
 		},
 		{
 			name:         "OTP preferred",
@@ -176,16 +172,6 @@ func TestTeleportClient_Login_local(t *testing.T) {
 			},
 			preferOTP: true,
 		},
-		{
-			name:         "Webauthn device login",
-			secondFactor: constants.SecondFactorOptional,
-			inputReader: prompt.NewFakeReader().
-				AddString(password).
-				AddReply(func(ctx context.Context) (string, error) {
-					panic("this should not be called")
-				}),
-			solveWebauthn: solveWebauthn,
-		},
 		{
 			name:          "passwordless login",
 			secondFactor:  constants.SecondFactorOptional,
@@ -218,7 +204,6 @@ func TestTeleportClient_Login_local(t *testing.T) {
 
 			tc, err := client.NewClient(cfg)
 			require.NoError(t, err)
-			tc.AllowStdinHijack = test.allowStdinHijack
 			tc.AuthConnector = test.authConnector
 			tc.PreferOTP = test.preferOTP
 
@@ -18,36 +18,17 @@ package client
 
 import (
 	"context"
-	"fmt"
-	"os"
-	"strings"
-	"sync"
-
-	"github.com/gravitational/trace"
 
 	"github.com/gravitational/teleport/api/client/proto"
 	wanlib "github.com/gravitational/teleport/lib/auth/webauthn"
 	wancli "github.com/gravitational/teleport/lib/auth/webauthncli"
-	"github.com/gravitational/teleport/lib/utils/prompt"
+	"github.com/gravitational/teleport/lib/auth/webauthnwin"
+	"github.com/gravitational/teleport/lib/client/mfa"
 )
 
 // promptWebauthn provides indirection for tests.
 var promptWebauthn = wancli.Login
 
-// mfaPrompt implements wancli.LoginPrompt for MFA logins.
-// In most cases authenticators shouldn't require PINs or additional touches for
-// MFA, but the implementation exists in case we find some unusual
-// authenticators out there.
-type mfaPrompt struct {
-	wancli.LoginPrompt
-	otpCancelAndWait func()
-}
-
-func (p *mfaPrompt) PromptPIN() (string, error) {
-	p.otpCancelAndWait()
-	return p.LoginPrompt.PromptPIN()
-}
-
 // PromptMFAChallengeOpts groups optional settings for PromptMFAChallenge.
 type PromptMFAChallengeOpts struct {
 	// PromptDevicePrefix is an optional prefix printed before "security key" or
@@ -57,16 +38,19 @@ type PromptMFAChallengeOpts struct {
 	// Quiet suppresses users prompts.
 	Quiet bool
 	// AllowStdinHijack allows stdin hijack during MFA prompts.
-	// Stdin hijack provides a better login UX, but it can be difficult to reason
-	// about and is often a source of bugs.
-	// Do not set this options unless you deeply understand what you are doing.
-	// If false then only the strongest auth method is prompted.
+	//
+	// Deprecated: concurrent prompts multiplex the shared stdin reader with
+	// cancellation, so the option is no longer needed and is ignored.
 	AllowStdinHijack bool
 	// AuthenticatorAttachment specifies the desired authenticator attachment.
 	AuthenticatorAttachment wancli.AuthenticatorAttachment
 	// PreferOTP favors OTP challenges, if applicable.
 	// Takes precedence over AuthenticatorAttachment settings.
 	PreferOTP bool
+	// PreferTouchID favors the platform authenticator (Touch ID), if the
+	// challenge and platform allow it, falling back to security keys if the
+	// Touch ID attempt fails.
+	PreferTouchID bool
 }
 
 // promptMFAStandalone is used to mock PromptMFAChallenge for tests.
@@ -98,153 +82,32 @@ func (tc *TeleportClient) PromptMFAChallenge(
 // PromptMFAChallenge prompts the user to complete MFA authentication
 // challenges.
 func PromptMFAChallenge(ctx context.Context, c *proto.MFAAuthenticateChallenge, proxyAddr string, opts *PromptMFAChallengeOpts) (*proto.MFAAuthenticateResponse, error) {
-	// Is there a challenge present?
-	if c.TOTP == nil && c.WebauthnChallenge == nil {
-		return &proto.MFAAuthenticateResponse{}, nil
-	}
 	if opts == nil {
 		opts = &PromptMFAChallengeOpts{}
 	}
-	promptDevicePrefix := opts.PromptDevicePrefix
-	quiet := opts.Quiet
-
-	hasTOTP := c.TOTP != nil
-	hasWebauthn := c.WebauthnChallenge != nil
-
-	// Does the current platform support hardware MFA? Adjust accordingly.
-	switch {
-	case !hasTOTP && !wancli.HasPlatformSupport():
-		return nil, trace.BadParameter("hardware device MFA not supported by your platform, please register an OTP device")
-	case !wancli.HasPlatformSupport():
-		// Do not prompt for hardware devices, it won't work.
-		hasWebauthn = false
-	}
-
-	// Tweak enabled/disabled methods according to opts.
-	switch {
-	case hasTOTP && opts.PreferOTP:
-		hasWebauthn = false
-	case hasWebauthn && opts.AuthenticatorAttachment != wancli.AttachmentAuto:
-		// Prefer Webauthn if an specific attachment was requested.
-		hasTOTP = false
-	case hasWebauthn && !opts.AllowStdinHijack:
-		// Use strongest auth if hijack is not allowed.
-		hasTOTP = false
-	}
-
-	var numGoroutines int
-	if hasTOTP && hasWebauthn {
-		numGoroutines = 2
-	} else {
-		numGoroutines = 1
-	}
-
-	type response struct {
-		kind string
-		resp *proto.MFAAuthenticateResponse
-		err  error
-	}
-	respC := make(chan response, numGoroutines)
 
-	// Use ctx and wg to clean up after ourselves.
-	ctx, cancel := context.WithCancel(ctx)
-	defer cancel()
-	var wg sync.WaitGroup
-	cancelAndWait := func() {
-		cancel()
-		wg.Wait()
-	}
-
-	// Use variables below to cancel OTP reads and make sure the goroutine exited.
-	otpWait := &sync.WaitGroup{}
-	otpCtx, otpCancel := context.WithCancel(ctx)
-	defer otpCancel()
-
-	// Fire TOTP goroutine.
-	if hasTOTP {
-		otpWait.Add(1)
-		wg.Add(1)
-		go func() {
-			defer otpWait.Done()
-			defer wg.Done()
-			const kind = "TOTP"
-
-			// Let Webauthn take the prompt, it knows better if it's necessary.
-			var msg string
-			if !quiet && !hasWebauthn {
-				msg = fmt.Sprintf("Enter an OTP code from a %sdevice", promptDevicePrefix)
-			}
-
-			otp, err := prompt.Password(otpCtx, os.Stderr, prompt.Stdin(), msg)
-			if err != nil {
-				respC <- response{kind: kind, err: err}
-				return
-			}
-			respC <- response{
-				kind: kind,
-				resp: &proto.MFAAuthenticateResponse{
-					Response: &proto.MFAAuthenticateResponse_TOTP{
-						TOTP: &proto.TOTPResponse{Code: otp},
-					},
-				},
-			}
-		}()
-	}
-
-	// Fire Webauthn goroutine.
-	if hasWebauthn {
-		origin := proxyAddr
-		if !strings.HasPrefix(origin, "https://") {
-			origin = "https://" + origin
-		}
-		wg.Add(1)
-		go func() {
-			defer wg.Done()
-			log.Debugf("WebAuthn: prompting devices with origin %q", origin)
-
-			prompt := wancli.NewDefaultPrompt(ctx, os.Stderr)
-			prompt.SecondTouchMessage = fmt.Sprintf("Tap your %ssecurity key to complete login", promptDevicePrefix)
-			switch {
-			case quiet:
-				// Do not prompt.
-				prompt.FirstTouchMessage = ""
-				prompt.SecondTouchMessage = ""
-			case hasTOTP: // Webauthn + OTP
-				prompt.FirstTouchMessage = fmt.Sprintf("Tap any %ssecurity key or enter a code from a %sOTP device", promptDevicePrefix, promptDevicePrefix)
-			default: // Webauthn only
-				prompt.FirstTouchMessage = fmt.Sprintf("Tap any %ssecurity key", promptDevicePrefix)
-			}
-			mfaPrompt := &mfaPrompt{LoginPrompt: prompt, otpCancelAndWait: func() {
-				otpCancel()
-				otpWait.Wait()
-			}}
-
-			resp, _, err := promptWebauthn(ctx, origin, wanlib.CredentialAssertionFromProto(c.WebauthnChallenge), mfaPrompt, &wancli.LoginOpts{
-				AuthenticatorAttachment: opts.AuthenticatorAttachment,
-			})
-			respC <- response{kind: "WEBAUTHN", resp: resp, err: err}
-		}()
-	}
-
-	for i := 0; i < numGoroutines; i++ {
-		select {
-		case resp := <-respC:
-			if err := resp.err; err != nil {
-				log.WithError(err).Debugf("%s authentication failed", resp.kind)
-				continue
-			}
-
-			// Cleanup in-flight goroutines.
-			cancelAndWait()
-			return resp.resp, nil
-		case <-ctx.Done():
-			cancelAndWait()
-			return nil, trace.Wrap(ctx.Err())
-		}
-	}
-	cancelAndWait()
-	return nil, trace.BadParameter(
-		"failed to authenticate using all MFA devices, rerun the command with '-d' to see error details for each device")
+	promptCfg := mfa.PromptConfig{
+		ProxyAddress:            proxyAddr,
+		WebauthnLoginFunc:       promptWebauthn,
+		WebauthnSupported:       wancli.HasPlatformSupport(),
+		AuthenticatorAttachment: opts.AuthenticatorAttachment,
+		PreferOTP:               opts.PreferOTP,
+		PreferTouchID:           opts.PreferTouchID,
+	}
+	// Prefer the native Windows WebAuthn API when present, so Windows Hello
+	// PIN/biometrics can satisfy the challenge.
+	if webauthnwin.IsAvailable() {
+		promptCfg.WebauthnLoginFunc = mfa.WindowsWebauthnLogin
+		promptCfg.WebauthnSupported = true
+	}
+
+	cliPrompt := mfa.NewCLIPrompt(&mfa.CLIPromptConfig{
+		PromptConfig:       promptCfg,
+		Quiet:              opts.Quiet,
+		PromptDevicePrefix: opts.PromptDevicePrefix,
+		AllowStdinHijack:   opts.AllowStdinHijack,
+	})
+	return cliPrompt.Run(ctx, c)
 }
 
 // MFAAuthenticateChallenge is an MFA authentication challenge sent on user
@@ -0,0 +1,114 @@
+/*
+Copyright 2022 Gravitational, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mfa
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+
+	"github.com/gravitational/teleport/api/client/proto"
+)
+
+// defaultResponseCacheTTL is how long cached MFA responses are reused unless
+// configured otherwise. Kept short on purpose: the cache exists to absorb
+// bursts of per-session MFA cert issuances from a single command, not to
+// extend the lifetime of a ceremony.
+const defaultResponseCacheTTL = 10 * time.Second
+
+// ResponseCache is an opt-in, per-process cache of successful MFA ceremony
+// results. Commands that trigger several MFA-gated operations in a row can
+// wrap their prompt with it so the user completes a single ceremony within
+// the window, instead of being prompted repeatedly.
+//
+// Entries are scoped by an opaque caller-chosen key, so responses for
+// different clusters or targets are never mixed. Note that the server must
+// accept a reused response within the window for the cache to be effective;
+// responses are single-use for most ceremonies.
+type ResponseCache struct {
+	ttl   time.Duration
+	clock clockwork.Clock
+
+	mu      sync.Mutex
+	entries map[string]responseCacheEntry
+}
+
+type responseCacheEntry struct {
+	resp    *proto.MFAAuthenticateResponse
+	expires time.Time
+}
+
+// NewResponseCache returns a new response cache. A non-positive ttl uses
+// defaultResponseCacheTTL.
+func NewResponseCache(ttl time.Duration) *ResponseCache {
+	if ttl <= 0 {
+		ttl = defaultResponseCacheTTL
+	}
+	return &ResponseCache{
+		ttl:     ttl,
+		clock:   clockwork.NewRealClock(),
+		entries: make(map[string]responseCacheEntry),
+	}
+}
+
+// Prompt wraps inner so successful responses are cached and reused under the
+// given scope until they expire.
+func (c *ResponseCache) Prompt(scope string, inner Prompt) Prompt {
+	return PromptFunc(func(ctx context.Context, chal *proto.MFAAuthenticateChallenge) (*proto.MFAAuthenticateResponse, error) {
+		if resp, ok := c.get(scope); ok {
+			return resp, nil
+		}
+		resp, err := inner.Run(ctx, chal)
+		if err != nil {
+			return nil, err
+		}
+		c.put(scope, resp)
+		return resp, nil
+	})
+}
+
+// Clear drops all cached responses.
+func (c *ResponseCache) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries = make(map[string]responseCacheEntry)
+}
+
+func (c *ResponseCache) get(scope string) (*proto.MFAAuthenticateResponse, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[scope]
+	if !ok {
+		return nil, false
+	}
+	if c.clock.Now().After(entry.expires) {
+		delete(c.entries, scope)
+		return nil, false
+	}
+	return entry.resp, true
+}
+
+func (c *ResponseCache) put(scope string, resp *proto.MFAAuthenticateResponse) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[scope] = responseCacheEntry{
+		resp:    resp,
+		expires: c.clock.Now().Add(c.ttl),
+	}
+}
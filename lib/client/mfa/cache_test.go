@@ -0,0 +1,99 @@
+/*
+Copyright 2022 Gravitational, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mfa
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gravitational/trace"
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gravitational/teleport/api/client/proto"
+)
+
+func TestResponseCache(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	clock := clockwork.NewFakeClock()
+	cache := &ResponseCache{
+		ttl:     time.Minute,
+		clock:   clock,
+		entries: make(map[string]responseCacheEntry),
+	}
+
+	var runs int
+	inner := PromptFunc(func(ctx context.Context, chal *proto.MFAAuthenticateChallenge) (*proto.MFAAuthenticateResponse, error) {
+		runs++
+		return &proto.MFAAuthenticateResponse{
+			Response: &proto.MFAAuthenticateResponse_TOTP{
+				TOTP: &proto.TOTPResponse{Code: "123456"},
+			},
+		}, nil
+	})
+	wrapped := cache.Prompt("cluster-a", inner)
+
+	chal := &proto.MFAAuthenticateChallenge{TOTP: &proto.TOTPChallenge{}}
+
+	// First run goes through the inner prompt, the second is served from
+	// the cache.
+	resp, err := wrapped.Run(ctx, chal)
+	require.NoError(t, err)
+	require.Equal(t, "123456", resp.GetTOTP().GetCode())
+	_, err = wrapped.Run(ctx, chal)
+	require.NoError(t, err)
+	require.Equal(t, 1, runs)
+
+	// Entries are scoped by key, so a different scope prompts again.
+	_, err = cache.Prompt("cluster-b", inner).Run(ctx, chal)
+	require.NoError(t, err)
+	require.Equal(t, 2, runs)
+
+	// Expired entries prompt again.
+	clock.Advance(2 * time.Minute)
+	_, err = wrapped.Run(ctx, chal)
+	require.NoError(t, err)
+	require.Equal(t, 3, runs)
+
+	// Clear drops all cached responses.
+	cache.Clear()
+	_, err = wrapped.Run(ctx, chal)
+	require.NoError(t, err)
+	require.Equal(t, 4, runs)
+}
+
+func TestResponseCacheDoesNotCacheErrors(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	cache := NewResponseCache(0)
+	var runs int
+	wrapped := cache.Prompt("scope", PromptFunc(func(ctx context.Context, chal *proto.MFAAuthenticateChallenge) (*proto.MFAAuthenticateResponse, error) {
+		runs++
+		return nil, trace.AccessDenied("nope")
+	}))
+
+	chal := &proto.MFAAuthenticateChallenge{TOTP: &proto.TOTPChallenge{}}
+	_, err := wrapped.Run(ctx, chal)
+	require.Error(t, err)
+	_, err = wrapped.Run(ctx, chal)
+	require.Error(t, err)
+	require.Equal(t, 2, runs)
+}
@@ -0,0 +1,622 @@
+/*
+Copyright 2022 Gravitational, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mfa
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gravitational/trace"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/gravitational/teleport/api/client/proto"
+	"github.com/gravitational/teleport/api/types"
+	"github.com/gravitational/teleport/lib/auth/touchid"
+	wanlib "github.com/gravitational/teleport/lib/auth/webauthn"
+	wancli "github.com/gravitational/teleport/lib/auth/webauthncli"
+	"github.com/gravitational/teleport/lib/utils/prompt"
+)
+
+// CLIPromptConfig contains CLI prompt config options.
+type CLIPromptConfig struct {
+	PromptConfig
+	// Writer is where the prompt outputs the prompt, defaults to os.Stderr.
+	Writer io.Writer
+	// Quiet suppresses users prompts.
+	Quiet bool
+	// PromptDevicePrefix is an optional prefix printed before "security key"
+	// or "device". It is used to emphasize between different kinds of
+	// devices, like registered vs new.
+	PromptDevicePrefix string
+	// AllowStdinHijack allows stdin hijack during MFA prompts.
+	//
+	// Deprecated: concurrent prompts multiplex the shared stdin
+	// ContextReader with cancellation, so OTP and Webauthn can race safely
+	// by default. The field is ignored.
+	AllowStdinHijack bool
+	// LoginPromptConstructor overrides how the wancli.LoginPrompt for
+	// Webauthn logins is built. If unset, the package-registered constructor
+	// is used, see SetLoginPromptConstructor.
+	LoginPromptConstructor LoginPromptConstructor
+	// DevicePicker, if set, adds an explicit selection step before Webauthn
+	// logins when more than one authenticator is attached, instead of the
+	// default first-touch-wins behavior. It only takes effect when an
+	// authenticator enumerator is registered, see
+	// SetAuthenticatorEnumerator and NewCLIDevicePicker.
+	DevicePicker DevicePicker
+	// EventsWriter, if set, receives newline-delimited JSON PromptEvent
+	// records describing the prompt lifecycle. See PromptEvent.
+	EventsWriter io.Writer
+}
+
+// LoginPromptConstructor builds the wancli.LoginPrompt used for Webauthn
+// logins. The touch messages are the texts the CLI prompt would display,
+// already adjusted for quiet mode and device prefixes; implementations are
+// free to ignore them.
+type LoginPromptConstructor func(ctx context.Context, w io.Writer, firstTouchMessage, secondTouchMessage string) wancli.LoginPrompt
+
+var (
+	loginPromptConstructorMu sync.RWMutex
+	loginPromptConstructor   LoginPromptConstructor = newDefaultLoginPrompt
+)
+
+// SetLoginPromptConstructor registers the LoginPrompt constructor used by
+// CLI prompts without an explicit CLIPromptConfig.LoginPromptConstructor.
+// It lets downstream tools, such as TUI apps, replace the stderr-based
+// prompt. Passing nil restores the default constructor.
+func SetLoginPromptConstructor(c LoginPromptConstructor) {
+	loginPromptConstructorMu.Lock()
+	defer loginPromptConstructorMu.Unlock()
+	if c == nil {
+		c = newDefaultLoginPrompt
+	}
+	loginPromptConstructor = c
+}
+
+func getLoginPromptConstructor() LoginPromptConstructor {
+	loginPromptConstructorMu.RLock()
+	defer loginPromptConstructorMu.RUnlock()
+	return loginPromptConstructor
+}
+
+func newDefaultLoginPrompt(ctx context.Context, w io.Writer, firstTouchMessage, secondTouchMessage string) wancli.LoginPrompt {
+	dp := wancli.NewDefaultPrompt(ctx, w)
+	dp.FirstTouchMessage = firstTouchMessage
+	dp.SecondTouchMessage = secondTouchMessage
+	return dp
+}
+
+// CLIPrompt is the default CLI mfa prompt. It prompts for OTP codes and
+// security key touches on the terminal.
+type CLIPrompt struct {
+	cfg CLIPromptConfig
+}
+
+// NewCLIPrompt returns a new CLI mfa prompt with the given config.
+func NewCLIPrompt(cfg *CLIPromptConfig) *CLIPrompt {
+	return &CLIPrompt{cfg: *cfg}
+}
+
+// writer returns the writer the prompt outputs to.
+func (c *CLIPrompt) writer() io.Writer {
+	if c.cfg.Writer != nil {
+		return c.cfg.Writer
+	}
+	return os.Stderr
+}
+
+// origin returns the Webauthn origin derived from the proxy address.
+func (c *CLIPrompt) origin() string {
+	origin := c.cfg.ProxyAddress
+	if !strings.HasPrefix(origin, "https://") {
+		origin = "https://" + origin
+	}
+	return origin
+}
+
+// RunBatch satisfies several challenges with as little user interaction as
+// the protocol allows: the user completes the first challenge normally and
+// the winning method is reapplied to the rest. A security key signs the
+// remaining challenges sequentially without re-prompting. OTP users are asked
+// for a fresh code per challenge: the server rejects previously used codes,
+// so a code cannot be replayed across challenges.
+func (c *CLIPrompt) RunBatch(ctx context.Context, chals []*proto.MFAAuthenticateChallenge) ([]*proto.MFAAuthenticateResponse, error) {
+	if len(chals) == 0 {
+		return nil, nil
+	}
+
+	first, err := c.Run(ctx, chals[0])
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	responses := make([]*proto.MFAAuthenticateResponse, 0, len(chals))
+	responses = append(responses, first)
+	for _, chal := range chals[1:] {
+		switch first.Response.(type) {
+		case *proto.MFAAuthenticateResponse_TOTP:
+			if chal.TOTP == nil {
+				return nil, trace.BadParameter("challenge does not offer TOTP, cannot batch-sign")
+			}
+			otp, err := c.promptOTPCode(ctx)
+			if err != nil {
+				return nil, trace.Wrap(err)
+			}
+			responses = append(responses, &proto.MFAAuthenticateResponse{
+				Response: &proto.MFAAuthenticateResponse_TOTP{
+					TOTP: &proto.TOTPResponse{Code: otp},
+				},
+			})
+		case *proto.MFAAuthenticateResponse_Webauthn:
+			if chal.WebauthnChallenge == nil {
+				return nil, trace.BadParameter("challenge does not offer Webauthn, cannot batch-sign")
+			}
+			// Quiet prompt, the device is already in the user's hand.
+			newPrompt := c.cfg.LoginPromptConstructor
+			if newPrompt == nil {
+				newPrompt = getLoginPromptConstructor()
+			}
+			resp, _, err := c.cfg.WebauthnLoginFunc(ctx, c.origin(), wanlib.CredentialAssertionFromProto(chal.WebauthnChallenge),
+				newPrompt(ctx, c.writer(), "", ""), &wancli.LoginOpts{
+					AuthenticatorAttachment: c.cfg.AuthenticatorAttachment,
+				})
+			if err != nil {
+				return nil, trace.Wrap(err)
+			}
+			responses = append(responses, resp)
+		default:
+			return nil, trace.BadParameter("unsupported response type %T for batch MFA", first.Response)
+		}
+	}
+	return responses, nil
+}
+
+// promptOTPCode obtains a single OTP code outside the full prompt ceremony,
+// either from the configured OTPProvider or from the terminal. The server
+// rejects reused codes, so callers must obtain a fresh code per challenge.
+func (c *CLIPrompt) promptOTPCode(ctx context.Context) (string, error) {
+	if c.cfg.OTPProvider != nil {
+		otp, err := c.cfg.OTPProvider(ctx)
+		return otp, trace.Wrap(err)
+	}
+	var msg string
+	if !c.cfg.Quiet {
+		msgs := c.cfg.Messages.withDefaults()
+		msg = fmt.Sprintf(msgs.EnterFreshOTPCode, c.cfg.PromptDevicePrefix)
+	}
+	otp, err := prompt.Password(ctx, c.writer(), prompt.Stdin(), msg)
+	return otp, trace.Wrap(err)
+}
+
+// loginPrompt implements wancli.LoginPrompt for MFA logins.
+// In most cases authenticators shouldn't require PINs or additional touches
+// for MFA, but the implementation exists in case we find some unusual
+// authenticators out there.
+type loginPrompt struct {
+	wancli.LoginPrompt
+	otpCancelAndWait func()
+}
+
+func (p *loginPrompt) PromptPIN() (string, error) {
+	p.otpCancelAndWait()
+	return p.LoginPrompt.PromptPIN()
+}
+
+// Run prompts the user to complete an MFA authentication challenge.
+func (c *CLIPrompt) Run(ctx context.Context, chal *proto.MFAAuthenticateChallenge) (*proto.MFAAuthenticateResponse, error) {
+	if c.cfg.Timeout <= 0 {
+		return c.run(ctx, chal)
+	}
+
+	// Bound the whole ceremony, with a visible countdown so users know how
+	// long they have left.
+	ctx, cancel := context.WithTimeout(ctx, c.cfg.Timeout)
+	defer cancel()
+	if !c.cfg.Quiet {
+		go c.renderCountdown(ctx)
+	}
+
+	resp, err := c.run(ctx, chal)
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return nil, trace.Wrap(&TimeoutError{Timeout: c.cfg.Timeout})
+	}
+	return resp, err
+}
+
+// renderCountdown periodically prints the time left until the ceremony times
+// out.
+func (c *CLIPrompt) renderCountdown(ctx context.Context) {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return
+	}
+	msgs := c.cfg.Messages.withDefaults()
+
+	interval := 30 * time.Second
+	if time.Until(deadline) < time.Minute {
+		interval = 10 * time.Second
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			left := time.Until(deadline).Round(time.Second)
+			if left <= 0 {
+				return
+			}
+			fmt.Fprintf(c.writer(), msgs.PromptCountdown+"\n", left)
+		}
+	}
+}
+
+func (c *CLIPrompt) run(ctx context.Context, chal *proto.MFAAuthenticateChallenge) (*proto.MFAAuthenticateResponse, error) {
+	// Headless logins are approved out of band, skip local prompts
+	// entirely.
+	if c.cfg.HeadlessCeremony != nil {
+		ceremony := *c.cfg.HeadlessCeremony
+		if ceremony.Messages == nil {
+			ceremony.Messages = c.cfg.Messages
+		}
+		var out io.Writer
+		if !c.cfg.Quiet {
+			out = c.writer()
+		}
+		resp, err := ceremony.Run(ctx, out)
+		return resp, trace.Wrap(err)
+	}
+
+	hasSSO := c.cfg.SSOMFACeremony != nil
+	msgs := c.cfg.Messages.withDefaults()
+
+	// Is there a challenge present?
+	if chal.TOTP == nil && chal.WebauthnChallenge == nil && !hasSSO {
+		return &proto.MFAAuthenticateResponse{}, nil
+	}
+
+	hasTOTP := chal.TOTP != nil
+	hasWebauthn := chal.WebauthnChallenge != nil
+
+	// Does the current platform support hardware MFA? Adjust accordingly.
+	switch {
+	case !hasTOTP && !hasSSO && !c.cfg.WebauthnSupported:
+		return nil, trace.BadParameter("hardware device MFA not supported by your platform, please register an OTP device")
+	case !c.cfg.WebauthnSupported:
+		// Do not prompt for hardware devices, it won't work.
+		hasWebauthn = false
+	}
+
+	// PreferredMethods picks the first offered method from the list and
+	// disables the others.
+	if len(c.cfg.PreferredMethods) > 0 {
+		offered := map[Method]bool{
+			MethodOTP:      hasTOTP,
+			MethodWebauthn: hasWebauthn,
+			MethodSSO:      hasSSO,
+		}
+		hasTOTP, hasWebauthn, hasSSO = false, false, false
+		for _, method := range c.cfg.PreferredMethods {
+			if !offered[method] {
+				continue
+			}
+			switch method {
+			case MethodOTP:
+				hasTOTP = true
+			case MethodWebauthn:
+				hasWebauthn = true
+			case MethodSSO:
+				hasSSO = true
+			}
+			break
+		}
+		if !hasTOTP && !hasWebauthn && !hasSSO {
+			return nil, trace.BadParameter("none of the preferred MFA methods %v are offered by this challenge", c.cfg.PreferredMethods)
+		}
+	}
+
+	// Tweak enabled/disabled methods according to the config.
+	attachment := c.cfg.AuthenticatorAttachment
+	switch {
+	case hasTOTP && c.cfg.PreferOTP:
+		hasWebauthn = false
+		hasSSO = false
+	case hasWebauthn && c.cfg.PreferTouchID && touchid.IsAvailable():
+		// Prefer the platform authenticator. The automatic attachment
+		// attempts Touch ID first and falls back to security keys if the
+		// attempt fails, see wancli.Login.
+		hasTOTP = false
+		attachment = wancli.AttachmentAuto
+	case hasWebauthn && attachment != wancli.AttachmentAuto:
+		// Prefer Webauthn if an specific attachment was requested.
+		hasTOTP = false
+	}
+
+	// Mention the registered devices able to satisfy the challenge, so
+	// users with several devices know which ones to reach for.
+	if c.cfg.DeviceLister != nil && !c.cfg.Quiet {
+		if deviceList := c.registeredDevicesList(ctx, hasTOTP, hasWebauthn); deviceList != "" {
+			fmt.Fprintf(c.writer(), msgs.RegisteredDevices+"\n", deviceList)
+		}
+	}
+
+	var numGoroutines int
+	var offered []Method
+	for _, m := range []struct {
+		method  Method
+		enabled bool
+	}{
+		{MethodOTP, hasTOTP},
+		{MethodWebauthn, hasWebauthn},
+		{MethodSSO, hasSSO},
+	} {
+		if m.enabled {
+			numGoroutines++
+			offered = append(offered, m.method)
+		}
+	}
+	c.emitEvent(PromptEvent{Type: EventChallengePresented, Methods: offered})
+	ceremonyStart := time.Now()
+
+	type response struct {
+		kind string
+		resp *proto.MFAAuthenticateResponse
+		err  error
+	}
+	respC := make(chan response, numGoroutines)
+
+	// Use ctx and wg to clean up after ourselves.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var wg sync.WaitGroup
+	cancelAndWait := func() {
+		cancel()
+		wg.Wait()
+	}
+
+	// All prompts read through the shared stdin ContextReader (see
+	// prompt.Stdin), which multiplexes a single underlying reader and lets
+	// abandoned reads be reclaimed. The variables below cancel OTP reads and
+	// make sure the goroutine exited.
+	otpWait := &sync.WaitGroup{}
+	otpCtx, otpCancel := context.WithCancel(ctx)
+	defer otpCancel()
+
+	// Fire TOTP goroutine.
+	if hasTOTP {
+		otpWait.Add(1)
+		wg.Add(1)
+		go func() {
+			defer otpWait.Done()
+			defer wg.Done()
+			const kind = "TOTP"
+
+			var otp string
+			var err error
+			if c.cfg.OTPProvider != nil {
+				// Non-interactive codes skip the terminal entirely.
+				otp, err = c.cfg.OTPProvider(otpCtx)
+			} else {
+				// Let Webauthn take the prompt, it knows better if it's necessary.
+				var msg string
+				if !c.cfg.Quiet && !hasWebauthn {
+					msg = fmt.Sprintf(msgs.EnterOTPCode, c.cfg.PromptDevicePrefix)
+				}
+				otp, err = prompt.Password(otpCtx, c.writer(), prompt.Stdin(), msg)
+			}
+			if err != nil {
+				respC <- response{kind: kind, err: err}
+				return
+			}
+			respC <- response{
+				kind: kind,
+				resp: &proto.MFAAuthenticateResponse{
+					Response: &proto.MFAAuthenticateResponse_TOTP{
+						TOTP: &proto.TOTPResponse{Code: otp},
+					},
+				},
+			}
+		}()
+	}
+
+	// Fire Webauthn goroutine.
+	if hasWebauthn {
+		origin := c.origin()
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			log.Debugf("WebAuthn: prompting devices with origin %q", origin)
+
+			var firstTouchMessage, secondTouchMessage string
+			switch {
+			case c.cfg.Quiet:
+				// Do not prompt.
+			case hasTOTP: // Webauthn + OTP
+				firstTouchMessage = fmt.Sprintf(msgs.TapSecurityKeyOrEnterOTP, c.cfg.PromptDevicePrefix, c.cfg.PromptDevicePrefix)
+				secondTouchMessage = fmt.Sprintf(msgs.TapSecurityKeyAgain, c.cfg.PromptDevicePrefix)
+			default: // Webauthn only
+				firstTouchMessage = fmt.Sprintf(msgs.TapSecurityKey, c.cfg.PromptDevicePrefix)
+				secondTouchMessage = fmt.Sprintf(msgs.TapSecurityKeyAgain, c.cfg.PromptDevicePrefix)
+			}
+			newPrompt := c.cfg.LoginPromptConstructor
+			if newPrompt == nil {
+				newPrompt = getLoginPromptConstructor()
+			}
+			basePrompt := newPrompt(ctx, c.writer(), firstTouchMessage, secondTouchMessage)
+			mfaPrompt := &loginPrompt{LoginPrompt: basePrompt, otpCancelAndWait: func() {
+				otpCancel()
+				otpWait.Wait()
+			}}
+
+			loginOpts := &wancli.LoginOpts{AuthenticatorAttachment: attachment}
+			if c.cfg.DevicePicker != nil {
+				if enumerate := getAuthenticatorEnumerator(); enumerate != nil {
+					infos, err := enumerate(ctx)
+					if err != nil {
+						log.WithError(err).Debug("WebAuthn: failed to enumerate authenticators")
+					} else if len(infos) > 1 {
+						// The picker owns stdin, stop OTP reads first.
+						otpCancel()
+						otpWait.Wait()
+						info, err := c.cfg.DevicePicker.PickAuthenticator(ctx, infos)
+						if err != nil {
+							respC <- response{kind: "WEBAUTHN", err: err}
+							return
+						}
+						loginOpts.DevicePath = info.Path
+					}
+				}
+			}
+
+			var resp *proto.MFAAuthenticateResponse
+			var err error
+			for attempt := 0; ; attempt++ {
+				resp, _, err = c.cfg.WebauthnLoginFunc(ctx, origin, wanlib.CredentialAssertionFromProto(chal.WebauthnChallenge), mfaPrompt, loginOpts)
+				if err == nil || ctx.Err() != nil ||
+					attempt >= c.cfg.WebauthnRetries || !isTransientWebauthnError(err) {
+					break
+				}
+				log.WithError(err).Debug("WebAuthn: retrying after transient device error")
+				if !c.cfg.Quiet {
+					fmt.Fprintln(c.writer(), msgs.RetrySecurityKey)
+				}
+			}
+			respC <- response{kind: "WEBAUTHN", resp: resp, err: err}
+		}()
+	}
+
+	// Fire SSO goroutine.
+	if hasSSO {
+		var out io.Writer
+		if !c.cfg.Quiet {
+			out = c.writer()
+		}
+		// Propagate prompt messages unless the ceremony sets its own.
+		ceremony := *c.cfg.SSOMFACeremony
+		if ceremony.Messages == nil {
+			ceremony.Messages = c.cfg.Messages
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := ceremony.Run(ctx, chal, out)
+			respC <- response{kind: "SSO", resp: resp, err: err}
+		}()
+	}
+
+	for i := 0; i < numGoroutines; i++ {
+		select {
+		case resp := <-respC:
+			if err := resp.err; err != nil {
+				c.emitEvent(PromptEvent{Type: EventFailure, Method: methodForResponseKind(resp.kind), Error: err.Error()})
+				recordAttempt(ctx, Attempt{
+					Method:   methodForResponseKind(resp.kind),
+					Duration: time.Since(ceremonyStart),
+					Outcome:  OutcomeFailure,
+					Error:    err.Error(),
+				})
+				log.WithError(err).Debugf("%s authentication failed", resp.kind)
+				continue
+			}
+
+			// Cleanup in-flight goroutines.
+			cancelAndWait()
+			c.emitEvent(PromptEvent{Type: EventMethodSelected, Method: methodForResponseKind(resp.kind)})
+			c.emitEvent(PromptEvent{Type: EventSuccess, Method: methodForResponseKind(resp.kind)})
+			recordAttempt(ctx, Attempt{
+				Method:   methodForResponseKind(resp.kind),
+				Duration: time.Since(ceremonyStart),
+				Outcome:  OutcomeSuccess,
+			})
+			return resp.resp, nil
+		case <-ctx.Done():
+			cancelAndWait()
+			c.emitEvent(PromptEvent{Type: EventFailure, Error: ctx.Err().Error()})
+			recordAttempt(ctx, Attempt{
+				Duration: time.Since(ceremonyStart),
+				Outcome:  OutcomeCanceled,
+				Error:    ctx.Err().Error(),
+			})
+			return nil, trace.Wrap(ctx.Err())
+		}
+	}
+	cancelAndWait()
+	err := trace.BadParameter(
+		"failed to authenticate using all MFA devices, rerun the command with '-d' to see error details for each device")
+	c.emitEvent(PromptEvent{Type: EventFailure, Error: err.Error()})
+	return nil, err
+}
+
+// registeredDevicesList returns a human-readable list of the registered
+// devices able to satisfy the challenge, or empty if the devices cannot be
+// listed or none apply.
+func (c *CLIPrompt) registeredDevicesList(ctx context.Context, withTOTP, withWebauthn bool) string {
+	devices, err := c.cfg.DeviceLister(ctx)
+	if err != nil {
+		log.WithError(err).Debug("Failed to list registered MFA devices")
+		return ""
+	}
+
+	var keyNames, otpNames []string
+	for _, dev := range devices {
+		switch dev.Device.(type) {
+		case *types.MFADevice_Totp:
+			if withTOTP {
+				otpNames = append(otpNames, dev.GetName())
+			}
+		case *types.MFADevice_U2F, *types.MFADevice_Webauthn:
+			if withWebauthn {
+				keyNames = append(keyNames, dev.GetName())
+			}
+		}
+	}
+
+	var parts []string
+	if len(keyNames) > 0 {
+		parts = append(parts, fmt.Sprintf("security keys: %v", strings.Join(keyNames, ", ")))
+	}
+	if len(otpNames) > 0 {
+		parts = append(parts, fmt.Sprintf("OTP devices: %v", strings.Join(otpNames, ", ")))
+	}
+	return strings.Join(parts, "; ")
+}
+
+// isTransientWebauthnError matches device errors worth retrying: flaky
+// transports, a busy device or a key removed mid-ceremony. Protocol and user
+// errors are never considered transient.
+func isTransientWebauthnError(err error) bool {
+	msg := strings.ToLower(err.Error())
+	for _, transient := range []string{
+		"device busy",
+		"device removed",
+		"device not found",
+		"tx error",
+		"rx error",
+	} {
+		if strings.Contains(msg, transient) {
+			return true
+		}
+	}
+	return false
+}
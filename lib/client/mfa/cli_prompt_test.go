@@ -0,0 +1,175 @@
+/*
+Copyright 2022 Gravitational, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mfa
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/gravitational/trace"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gravitational/teleport/api/client/proto"
+	wantypes "github.com/gravitational/teleport/api/types/webauthn"
+	wanlib "github.com/gravitational/teleport/lib/auth/webauthn"
+	wancli "github.com/gravitational/teleport/lib/auth/webauthncli"
+)
+
+func TestRunBatchOTP(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	// The provider hands out a different code per call, mimicking an
+	// authenticator rotating codes between challenges.
+	var calls int
+	prompt := NewCLIPrompt(&CLIPromptConfig{
+		PromptConfig: PromptConfig{
+			OTPProvider: func(ctx context.Context) (string, error) {
+				calls++
+				return fmt.Sprintf("%06d", calls), nil
+			},
+		},
+		Writer: &bytes.Buffer{},
+		Quiet:  true,
+	})
+
+	chals := []*proto.MFAAuthenticateChallenge{
+		{TOTP: &proto.TOTPChallenge{}},
+		{TOTP: &proto.TOTPChallenge{}},
+		{TOTP: &proto.TOTPChallenge{}},
+	}
+	resps, err := prompt.RunBatch(ctx, chals)
+	require.NoError(t, err)
+	require.Len(t, resps, len(chals))
+
+	// Each challenge must be answered with a fresh code: the server
+	// rejects reused TOTP codes.
+	seen := make(map[string]bool)
+	for _, resp := range resps {
+		code := resp.GetTOTP().GetCode()
+		require.NotEmpty(t, code)
+		require.False(t, seen[code], "TOTP code %v reused across batched challenges", code)
+		seen[code] = true
+	}
+}
+
+func TestRunBatchWebauthn(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	var logins int
+	prompt := NewCLIPrompt(&CLIPromptConfig{
+		PromptConfig: PromptConfig{
+			WebauthnSupported: true,
+			WebauthnLoginFunc: func(
+				ctx context.Context,
+				origin string, assertion *wanlib.CredentialAssertion, prompt wancli.LoginPrompt, opts *wancli.LoginOpts,
+			) (*proto.MFAAuthenticateResponse, string, error) {
+				logins++
+				return &proto.MFAAuthenticateResponse{
+					Response: &proto.MFAAuthenticateResponse_Webauthn{
+						Webauthn: &wantypes.CredentialAssertionResponse{
+							RawId: []byte(fmt.Sprintf("credential-%d", logins)),
+						},
+					},
+				}, "", nil
+			},
+		},
+		Writer: &bytes.Buffer{},
+		Quiet:  true,
+	})
+
+	webauthnChallenge := &wantypes.CredentialAssertion{
+		PublicKey: &wantypes.PublicKeyCredentialRequestOptions{
+			Challenge: []byte("challenge"),
+		},
+	}
+	chals := []*proto.MFAAuthenticateChallenge{
+		{WebauthnChallenge: webauthnChallenge},
+		{WebauthnChallenge: webauthnChallenge},
+		{WebauthnChallenge: webauthnChallenge},
+	}
+	resps, err := prompt.RunBatch(ctx, chals)
+	require.NoError(t, err)
+	require.Len(t, resps, len(chals))
+	// The security key signs each challenge separately.
+	require.Equal(t, len(chals), logins)
+	for _, resp := range resps {
+		require.NotNil(t, resp.GetWebauthn())
+	}
+}
+
+func TestRunBatchMismatchedChallenge(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	prompt := NewCLIPrompt(&CLIPromptConfig{
+		PromptConfig: PromptConfig{
+			OTPProvider: func(ctx context.Context) (string, error) {
+				return "123456", nil
+			},
+		},
+		Writer: &bytes.Buffer{},
+		Quiet:  true,
+	})
+
+	// The first challenge is won with OTP, but the second does not offer
+	// it, so the batch cannot be completed.
+	chals := []*proto.MFAAuthenticateChallenge{
+		{TOTP: &proto.TOTPChallenge{}},
+		{WebauthnChallenge: &wantypes.CredentialAssertion{}},
+	}
+	_, err := prompt.RunBatch(ctx, chals)
+	require.True(t, trace.IsBadParameter(err))
+}
+
+func TestRunBatchEmpty(t *testing.T) {
+	t.Parallel()
+
+	prompt := NewCLIPrompt(&CLIPromptConfig{})
+	resps, err := prompt.RunBatch(context.Background(), nil)
+	require.NoError(t, err)
+	require.Empty(t, resps)
+}
+
+func TestIsTransientWebauthnError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		err       error
+		transient bool
+	}{
+		{name: "device busy", err: errors.New("hid: device busy"), transient: true},
+		{name: "device removed", err: errors.New("Device removed mid-ceremony"), transient: true},
+		{name: "device not found", err: errors.New("device not found"), transient: true},
+		{name: "tx error", err: errors.New("u2f: tx error"), transient: true},
+		{name: "rx error", err: errors.New("u2f: rx error"), transient: true},
+		{name: "user cancellation", err: errors.New("operation cancelled by the user"), transient: false},
+		{name: "protocol error", err: errors.New("webauthn: invalid assertion signature"), transient: false},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tc.transient, isTransientWebauthnError(tc.err))
+		})
+	}
+}
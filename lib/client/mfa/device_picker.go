@@ -0,0 +1,117 @@
+/*
+Copyright 2022 Gravitational, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mfa
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"sync"
+
+	"github.com/gravitational/trace"
+
+	"github.com/gravitational/teleport/lib/utils/prompt"
+)
+
+// AuthenticatorInfo describes an attached authenticator, for device pickers.
+type AuthenticatorInfo struct {
+	// Path is the platform-specific device path or identifier, understood
+	// by wancli.LoginOpts.DevicePath.
+	Path string
+	// Name is a human-readable product name, if known.
+	Name string
+	// Transport describes how the device is attached, eg "usb" or
+	// "platform".
+	Transport string
+}
+
+// String returns a human-readable representation of the authenticator.
+func (a AuthenticatorInfo) String() string {
+	name := a.Name
+	if name == "" {
+		name = a.Path
+	}
+	if a.Transport != "" {
+		return fmt.Sprintf("%v (%v)", name, a.Transport)
+	}
+	return name
+}
+
+// AuthenticatorEnumerator lists the attached authenticators. Enumeration is
+// platform and build dependent, so implementations register themselves via
+// SetAuthenticatorEnumerator; without one, device pickers are skipped.
+type AuthenticatorEnumerator func(ctx context.Context) ([]AuthenticatorInfo, error)
+
+var (
+	authenticatorEnumeratorMu sync.RWMutex
+	authenticatorEnumerator   AuthenticatorEnumerator
+)
+
+// SetAuthenticatorEnumerator registers the enumerator used by device
+// pickers.
+func SetAuthenticatorEnumerator(e AuthenticatorEnumerator) {
+	authenticatorEnumeratorMu.Lock()
+	defer authenticatorEnumeratorMu.Unlock()
+	authenticatorEnumerator = e
+}
+
+func getAuthenticatorEnumerator() AuthenticatorEnumerator {
+	authenticatorEnumeratorMu.RLock()
+	defer authenticatorEnumeratorMu.RUnlock()
+	return authenticatorEnumerator
+}
+
+// DevicePicker chooses among multiple attached authenticators, so users with
+// several keys pick one explicitly instead of relying on first-touch-wins.
+type DevicePicker interface {
+	// PickAuthenticator returns one of the given authenticators. Callers
+	// guarantee infos has at least two entries.
+	PickAuthenticator(ctx context.Context, infos []AuthenticatorInfo) (*AuthenticatorInfo, error)
+}
+
+// NewCLIDevicePicker returns a terminal-based DevicePicker writing to w, or
+// os.Stderr if w is nil. It presents a numbered list and reads the selection
+// through the shared stdin reader.
+func NewCLIDevicePicker(w io.Writer) DevicePicker {
+	if w == nil {
+		w = os.Stderr
+	}
+	return &cliDevicePicker{writer: w}
+}
+
+type cliDevicePicker struct {
+	writer io.Writer
+}
+
+func (p *cliDevicePicker) PickAuthenticator(ctx context.Context, infos []AuthenticatorInfo) (*AuthenticatorInfo, error) {
+	fmt.Fprintln(p.writer, "Multiple security keys detected:")
+	for i, info := range infos {
+		fmt.Fprintf(p.writer, "  [%d] %v\n", i+1, info)
+	}
+
+	answer, err := prompt.Input(ctx, p.writer, prompt.Stdin(), fmt.Sprintf("Choose a security key [1-%d]", len(infos)))
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+	choice, err := strconv.Atoi(answer)
+	if err != nil || choice < 1 || choice > len(infos) {
+		return nil, trace.BadParameter("invalid security key choice %q", answer)
+	}
+	return &infos[choice-1], nil
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2022 Gravitational, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mfa
+
+import (
+	"encoding/json"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Prompt event types, see PromptEvent.
+const (
+	// EventChallengePresented is emitted when the prompt starts, listing
+	// the methods offered to the user.
+	EventChallengePresented = "challenge-presented"
+	// EventMethodSelected is emitted when a method completes and is chosen
+	// as the ceremony result.
+	EventMethodSelected = "method-selected"
+	// EventSuccess is emitted when the ceremony succeeds.
+	EventSuccess = "success"
+	// EventFailure is emitted when a method or the whole ceremony fails.
+	// Method is empty for ceremony-wide failures.
+	EventFailure = "failure"
+)
+
+// PromptEvent is a machine-readable record of the prompt lifecycle, emitted
+// as newline-delimited JSON to CLIPromptConfig.EventsWriter. It lets GUI
+// wrappers and tests observe the MFA flow without parsing human text.
+type PromptEvent struct {
+	// Type is the event type, one of the Event* constants.
+	Type string `json:"type"`
+	// Time is when the event was emitted.
+	Time time.Time `json:"time"`
+	// Methods lists the offered methods, for challenge-presented events.
+	Methods []Method `json:"methods,omitempty"`
+	// Method is the method the event refers to, if any.
+	Method Method `json:"method,omitempty"`
+	// Error is the failure message, for failure events.
+	Error string `json:"error,omitempty"`
+}
+
+// emitEvent writes the event to the configured events writer, if any.
+// Events are only emitted from the prompt's main goroutine, so writes are
+// never concurrent.
+func (c *CLIPrompt) emitEvent(event PromptEvent) {
+	if c.cfg.EventsWriter == nil {
+		return
+	}
+	event.Time = time.Now().UTC()
+	if err := json.NewEncoder(c.cfg.EventsWriter).Encode(event); err != nil {
+		log.WithError(err).Debug("Failed to emit MFA prompt event")
+	}
+}
+
+// methodForResponseKind maps the internal response kinds used by CLIPrompt
+// to their public Method values.
+func methodForResponseKind(kind string) Method {
+	switch kind {
+	case "TOTP":
+		return MethodOTP
+	case "WEBAUTHN":
+		return MethodWebauthn
+	case "SSO":
+		return MethodSSO
+	}
+	return Method(kind)
+}
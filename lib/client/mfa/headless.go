@@ -0,0 +1,103 @@
+/*
+Copyright 2022 Gravitational, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mfa
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/gravitational/trace"
+
+	"github.com/gravitational/teleport/api/client/proto"
+)
+
+// defaultHeadlessPollInterval is how often a headless ceremony polls for
+// approval unless configured otherwise.
+const defaultHeadlessPollInterval = time.Second
+
+// HeadlessCeremony drives headless logins: the terminal displays the request
+// ID and key fingerprint and instructs the user to approve from another,
+// MFA-capable device, while the ceremony polls the cluster (eg, via
+// GetHeadlessAuthentication) until the request is approved or denied.
+type HeadlessCeremony struct {
+	// RequestID identifies the headless authentication request.
+	RequestID string
+	// Fingerprint is the fingerprint of the key awaiting approval, shown so
+	// users can verify what they are approving.
+	Fingerprint string
+	// Poll reports whether the request has been approved. A nil response
+	// with a nil error means the request is still pending and Poll will be
+	// called again after PollInterval. Denials surface as errors.
+	Poll func(ctx context.Context, requestID string) (*proto.MFAAuthenticateResponse, error)
+	// PollInterval overrides how often Poll is called. Defaults to
+	// defaultHeadlessPollInterval.
+	PollInterval time.Duration
+	// Messages overrides the user-facing ceremony strings. Empty fields
+	// fall back to DefaultMessages.
+	Messages *Messages
+}
+
+// CheckAndSetDefaults validates the ceremony configuration.
+func (c *HeadlessCeremony) CheckAndSetDefaults() error {
+	if c.RequestID == "" {
+		return trace.BadParameter("missing RequestID")
+	}
+	if c.Poll == nil {
+		return trace.BadParameter("missing Poll")
+	}
+	if c.PollInterval <= 0 {
+		c.PollInterval = defaultHeadlessPollInterval
+	}
+	return nil
+}
+
+// Run performs the headless approval ceremony, blocking until the request is
+// approved or the context is canceled. Progress messages are written to out,
+// which may be nil.
+func (c *HeadlessCeremony) Run(ctx context.Context, out io.Writer) (*proto.MFAAuthenticateResponse, error) {
+	if err := c.CheckAndSetDefaults(); err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	if out != nil {
+		msgs := c.Messages.withDefaults()
+		fmt.Fprintf(out, msgs.HeadlessApproval+"\n", c.RequestID)
+		if c.Fingerprint != "" {
+			fmt.Fprintf(out, msgs.HeadlessFingerprint+"\n", c.Fingerprint)
+		}
+	}
+
+	ticker := time.NewTicker(c.PollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, trace.Wrap(ctx.Err())
+		case <-ticker.C:
+		}
+
+		resp, err := c.Poll(ctx, c.RequestID)
+		if err != nil {
+			return nil, trace.Wrap(err)
+		}
+		if resp != nil {
+			return resp, nil
+		}
+	}
+}
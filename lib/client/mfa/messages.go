@@ -0,0 +1,118 @@
+/*
+Copyright 2022 Gravitational, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mfa
+
+// Messages contains the user-facing strings emitted by MFA prompts, so
+// embedders can localize or rebrand the UX without forking the package.
+// Fields left empty fall back to the corresponding DefaultMessages entry.
+// Fields are fmt format strings; the doc comment of each field describes the
+// verbs it receives.
+type Messages struct {
+	// EnterOTPCode prompts for an OTP code. Receives the device prefix,
+	// which may be empty.
+	EnterOTPCode string
+	// EnterFreshOTPCode prompts for another OTP code during a batch of
+	// challenges, reminding the user that codes cannot be reused. Receives
+	// the device prefix, which may be empty.
+	EnterFreshOTPCode string
+	// TapSecurityKeyOrEnterOTP asks for a security key touch or an OTP
+	// code. Receives the device prefix twice.
+	TapSecurityKeyOrEnterOTP string
+	// TapSecurityKey asks for a security key touch. Receives the device
+	// prefix.
+	TapSecurityKey string
+	// TapSecurityKeyAgain asks for the second security key touch. Receives
+	// the device prefix.
+	TapSecurityKeyAgain string
+	// FollowSSOLink asks the user to follow the IdP link. Receives the
+	// redirect URL.
+	FollowSSOLink string
+	// FollowSSOLinkBrowserOpened is the FollowSSOLink variant used after the
+	// browser was opened automatically. Receives the redirect URL.
+	FollowSSOLinkBrowserOpened string
+	// RegisteredDevices announces which registered devices can satisfy the
+	// challenge. Receives the formatted device list.
+	RegisteredDevices string
+	// RetrySecurityKey asks for another security key attempt after a
+	// transient device error. No verbs.
+	RetrySecurityKey string
+	// HeadlessApproval asks the user to approve a headless login from
+	// another device. Receives the request ID.
+	HeadlessApproval string
+	// HeadlessFingerprint shows the key fingerprint awaiting approval.
+	// Receives the fingerprint.
+	HeadlessFingerprint string
+	// PromptCountdown warns how long is left before the ceremony times
+	// out. Receives the remaining duration.
+	PromptCountdown string
+	// NotificationTitle is the desktop notification title. No verbs.
+	NotificationTitle string
+	// MFARequired is the desktop notification body. No verbs.
+	MFARequired string
+	// MFARequiredForProxy is the MFARequired variant used when the proxy
+	// address is known. Receives the proxy address.
+	MFARequiredForProxy string
+}
+
+// DefaultMessages are the built-in English prompt strings.
+var DefaultMessages = Messages{
+	EnterOTPCode:               "Enter an OTP code from a %sdevice",
+	EnterFreshOTPCode:          "Wait for a new code, then enter an OTP code from a %sdevice (codes cannot be reused)",
+	TapSecurityKeyOrEnterOTP:   "Tap any %ssecurity key or enter a code from a %sOTP device",
+	TapSecurityKey:             "Tap any %ssecurity key",
+	TapSecurityKeyAgain:        "Tap your %ssecurity key to complete login",
+	FollowSSOLink:              "Follow %v to complete the MFA check",
+	FollowSSOLinkBrowserOpened: "If your browser did not open automatically, follow %v to complete the MFA check",
+	RegisteredDevices:          "Registered MFA devices: %v",
+	RetrySecurityKey:           "Device error, tap your security key to try again",
+	HeadlessApproval:           "Approve the headless login from an authenticated device, request ID: %v",
+	HeadlessFingerprint:        "Key fingerprint: %v",
+	PromptCountdown:            "MFA prompt times out in %v",
+	NotificationTitle:          "Teleport",
+	MFARequired:                "Multi-factor authentication required, continue in your terminal",
+	MFARequiredForProxy:        "Multi-factor authentication required for %v, continue in your terminal",
+}
+
+// withDefaults returns a copy of m with empty fields replaced by the
+// corresponding DefaultMessages entry. A nil m yields DefaultMessages.
+func (m *Messages) withDefaults() Messages {
+	out := DefaultMessages
+	if m == nil {
+		return out
+	}
+	pick := func(dst *string, val string) {
+		if val != "" {
+			*dst = val
+		}
+	}
+	pick(&out.EnterOTPCode, m.EnterOTPCode)
+	pick(&out.EnterFreshOTPCode, m.EnterFreshOTPCode)
+	pick(&out.TapSecurityKeyOrEnterOTP, m.TapSecurityKeyOrEnterOTP)
+	pick(&out.TapSecurityKey, m.TapSecurityKey)
+	pick(&out.TapSecurityKeyAgain, m.TapSecurityKeyAgain)
+	pick(&out.FollowSSOLink, m.FollowSSOLink)
+	pick(&out.FollowSSOLinkBrowserOpened, m.FollowSSOLinkBrowserOpened)
+	pick(&out.RegisteredDevices, m.RegisteredDevices)
+	pick(&out.RetrySecurityKey, m.RetrySecurityKey)
+	pick(&out.HeadlessApproval, m.HeadlessApproval)
+	pick(&out.HeadlessFingerprint, m.HeadlessFingerprint)
+	pick(&out.PromptCountdown, m.PromptCountdown)
+	pick(&out.NotificationTitle, m.NotificationTitle)
+	pick(&out.MFARequired, m.MFARequired)
+	pick(&out.MFARequiredForProxy, m.MFARequiredForProxy)
+	return out
+}
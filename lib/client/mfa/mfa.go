@@ -0,0 +1,166 @@
+/*
+Copyright 2022 Gravitational, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package mfa implements client-side MFA prompts.
+package mfa
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gravitational/teleport/api/client/proto"
+	"github.com/gravitational/teleport/api/types"
+	wanlib "github.com/gravitational/teleport/lib/auth/webauthn"
+	wancli "github.com/gravitational/teleport/lib/auth/webauthncli"
+	"github.com/gravitational/teleport/lib/auth/webauthnwin"
+)
+
+// Prompt is an MFA prompt.
+type Prompt interface {
+	// Run prompts the user to complete an MFA authentication challenge.
+	Run(ctx context.Context, chal *proto.MFAAuthenticateChallenge) (*proto.MFAAuthenticateResponse, error)
+}
+
+// BatchPrompt is a Prompt that can satisfy several challenges with a single
+// user interaction, where the protocol allows it. See CLIPrompt.RunBatch.
+type BatchPrompt interface {
+	Prompt
+	// RunBatch prompts the user to complete several MFA authentication
+	// challenges at once, returning one response per challenge, in order.
+	RunBatch(ctx context.Context, chals []*proto.MFAAuthenticateChallenge) ([]*proto.MFAAuthenticateResponse, error)
+}
+
+// PromptFunc is a function wrapper that implements the Prompt interface.
+type PromptFunc func(ctx context.Context, chal *proto.MFAAuthenticateChallenge) (*proto.MFAAuthenticateResponse, error)
+
+// Run prompts the user to complete an MFA authentication challenge.
+func (f PromptFunc) Run(ctx context.Context, chal *proto.MFAAuthenticateChallenge) (*proto.MFAAuthenticateResponse, error) {
+	return f(ctx, chal)
+}
+
+// WebauthnLoginFunc performs client-side Webauthn login.
+type WebauthnLoginFunc func(
+	ctx context.Context,
+	origin string, assertion *wanlib.CredentialAssertion, prompt wancli.LoginPrompt, opts *wancli.LoginOpts,
+) (*proto.MFAAuthenticateResponse, string, error)
+
+// Method is an MFA method a prompt can use to satisfy a challenge.
+type Method string
+
+const (
+	// MethodOTP answers challenges with a TOTP code.
+	MethodOTP Method = "otp"
+	// MethodWebauthn answers challenges with a Webauthn device.
+	MethodWebauthn Method = "webauthn"
+	// MethodSSO answers challenges through an IdP-backed ceremony.
+	MethodSSO Method = "sso"
+)
+
+// PromptConfig contains common mfa prompt config options shared by prompt
+// implementations.
+type PromptConfig struct {
+	// ProxyAddress is the address of the authenticating proxy, used as the
+	// Webauthn origin.
+	ProxyAddress string
+	// WebauthnLoginFunc performs client-side Webauthn login.
+	WebauthnLoginFunc WebauthnLoginFunc
+	// WebauthnSupported indicates whether Webauthn is supported on this
+	// platform.
+	WebauthnSupported bool
+	// AuthenticatorAttachment specifies the desired authenticator attachment.
+	AuthenticatorAttachment wancli.AuthenticatorAttachment
+	// PreferOTP favors OTP challenges, if applicable.
+	// Takes precedence over AuthenticatorAttachment settings.
+	PreferOTP bool
+	// PreferTouchID favors the platform authenticator (Touch ID) when the
+	// challenge allows it, falling back to security keys if the Touch ID
+	// attempt fails. It has no effect on platforms without a platform
+	// authenticator.
+	PreferTouchID bool
+	// SSOMFACeremony, if set, enables an IdP-backed MFA flow alongside OTP
+	// and Webauthn prompts. See SSOMFACeremony.
+	SSOMFACeremony *SSOMFACeremony
+	// HeadlessCeremony, if set, replaces local prompts entirely: the
+	// challenge is satisfied by an approval from another device. See
+	// HeadlessCeremony.
+	HeadlessCeremony *HeadlessCeremony
+	// OTPProvider, if set, supplies TOTP codes instead of reading them from
+	// the terminal. See EnvOTPProvider and FileOTPProvider.
+	OTPProvider OTPProvider
+	// DeviceLister, if set, fetches the user's registered MFA devices (eg,
+	// via GetMFADevices) so prompts can mention them by name and users know
+	// which devices satisfy the challenge. Listing failures are logged and
+	// otherwise ignored.
+	DeviceLister func(ctx context.Context) ([]*types.MFADevice, error)
+	// WebauthnRetries is how many additional Webauthn attempts are allowed
+	// after a transient device error, such as a busy device or a key
+	// removed mid-ceremony. Zero disables retries; fatal errors are never
+	// retried.
+	WebauthnRetries int
+	// Timeout bounds the whole ceremony, across all methods. When exceeded
+	// the prompt returns a TimeoutError. Zero means no timeout.
+	Timeout time.Duration
+	// Messages overrides the user-facing prompt strings, for localization
+	// or rebranding. Empty fields fall back to DefaultMessages.
+	Messages *Messages
+	// PreferredMethods restricts prompting to the first method in the list
+	// that the challenge offers, in order of preference. Methods not listed
+	// are never prompted. If empty, all offered methods are considered and
+	// the prompt applies its default selection (see PreferOTP and
+	// AuthenticatorAttachment).
+	PreferredMethods []Method
+}
+
+// TimeoutError is returned when an MFA ceremony exceeds
+// PromptConfig.Timeout.
+type TimeoutError struct {
+	// Timeout is the configured ceremony timeout.
+	Timeout time.Duration
+}
+
+// Error implements the error interface.
+func (e *TimeoutError) Error() string {
+	return fmt.Sprintf("MFA ceremony timed out after %v", e.Timeout)
+}
+
+// NewPromptConfig returns a prompt config for the given proxy address, with
+// client-side Webauthn login enabled if the platform supports it. On Windows
+// systems with webauthn.dll it selects WindowsWebauthnLogin, so Windows Hello
+// PIN/biometrics can satisfy challenges.
+func NewPromptConfig(proxyAddress string) *PromptConfig {
+	cfg := &PromptConfig{
+		ProxyAddress:      proxyAddress,
+		WebauthnLoginFunc: wancli.Login,
+		WebauthnSupported: wancli.HasPlatformSupport(),
+	}
+	if webauthnwin.IsAvailable() {
+		cfg.WebauthnLoginFunc = WindowsWebauthnLogin
+		cfg.WebauthnSupported = true
+	}
+	return cfg
+}
+
+// WindowsWebauthnLogin is a WebauthnLoginFunc backed by the native Windows
+// WebAuthn API (webauthn.dll). The native API drives its own UI, so the
+// prompt and attachment options are ignored. Use webauthnwin.IsAvailable to
+// check for support before selecting it.
+func WindowsWebauthnLogin(
+	ctx context.Context,
+	origin string, assertion *wanlib.CredentialAssertion, prompt wancli.LoginPrompt, opts *wancli.LoginOpts,
+) (*proto.MFAAuthenticateResponse, string, error) {
+	return webauthnwin.Login(ctx, origin, assertion)
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2022 Gravitational, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mfa
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"runtime"
+	"strings"
+
+	"github.com/gravitational/trace"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/gravitational/teleport/api/client/proto"
+)
+
+// NotifyPromptConfig contains notify prompt config options.
+type NotifyPromptConfig struct {
+	// ProxyAddress is the address of the authenticating proxy, shown in the
+	// notification so users know which cluster is asking.
+	ProxyAddress string
+	// Prompt is the prompt to delegate to once the notification is raised,
+	// typically a CLIPrompt.
+	Prompt Prompt
+	// Messages overrides the notification strings. Empty fields fall back
+	// to DefaultMessages.
+	Messages *Messages
+
+	// notify raises the notification, overridable in tests. Defaults to
+	// sendDesktopNotification.
+	notify func(ctx context.Context, title, message string) error
+}
+
+// NotifyPrompt is a Prompt that raises a desktop notification before
+// delegating to an inner prompt. It helps users running tsh from
+// GUI-launched tools, where the terminal asking for MFA may not be visible.
+type NotifyPrompt struct {
+	cfg NotifyPromptConfig
+}
+
+// NewNotifyPrompt returns a new notify prompt with the given config.
+func NewNotifyPrompt(cfg *NotifyPromptConfig) *NotifyPrompt {
+	if cfg.notify == nil {
+		cfg.notify = sendDesktopNotification
+	}
+	return &NotifyPrompt{cfg: *cfg}
+}
+
+// Run raises a desktop notification, best-effort, then delegates to the
+// inner prompt to complete the challenge.
+func (p *NotifyPrompt) Run(ctx context.Context, chal *proto.MFAAuthenticateChallenge) (*proto.MFAAuthenticateResponse, error) {
+	if p.cfg.Prompt == nil {
+		return nil, trace.BadParameter("notify prompt requires an inner prompt")
+	}
+
+	msgs := p.cfg.Messages.withDefaults()
+	message := msgs.MFARequired
+	if p.cfg.ProxyAddress != "" {
+		message = fmt.Sprintf(msgs.MFARequiredForProxy, p.cfg.ProxyAddress)
+	}
+	// The notification is purely informational, don't let it delay or fail
+	// the actual prompt.
+	go func() {
+		if err := p.cfg.notify(ctx, msgs.NotificationTitle, message); err != nil {
+			log.WithError(err).Debug("Failed to send desktop notification")
+		}
+	}()
+
+	return p.cfg.Prompt.Run(ctx, chal)
+}
+
+// sendDesktopNotification raises an OS notification using the tooling
+// typically present on each platform.
+func sendDesktopNotification(ctx context.Context, title, message string) error {
+	switch runtime.GOOS {
+	case "darwin":
+		script := fmt.Sprintf("display notification %q with title %q", sanitizeNotification(message), sanitizeNotification(title))
+		return trace.Wrap(exec.CommandContext(ctx, "osascript", "-e", script).Run())
+	case "linux":
+		return trace.Wrap(exec.CommandContext(ctx, "notify-send", "--", title, message).Run())
+	case "windows":
+		return trace.Wrap(exec.CommandContext(ctx, "msg", "*", "/TIME:5", fmt.Sprintf("%v: %v", title, message)).Run())
+	default:
+		return trace.NotImplemented("desktop notifications not supported on %v", runtime.GOOS)
+	}
+}
+
+// sanitizeNotification strips characters that could escape the AppleScript
+// string literals built above.
+func sanitizeNotification(s string) string {
+	return strings.NewReplacer(`"`, "'", `\`, "").Replace(s)
+}
@@ -0,0 +1,59 @@
+/*
+Copyright 2022 Gravitational, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mfa
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	"github.com/gravitational/trace"
+)
+
+// OTPProvider supplies a TOTP code without prompting the user, allowing
+// scripts and CI jobs to answer OTP challenges without a TTY.
+type OTPProvider func(ctx context.Context) (string, error)
+
+// EnvOTPProvider returns an OTPProvider that reads the code from the given
+// environment variable. The variable is read when the challenge is prompted,
+// not when the provider is built.
+func EnvOTPProvider(envVar string) OTPProvider {
+	return func(ctx context.Context) (string, error) {
+		otp := strings.TrimSpace(os.Getenv(envVar))
+		if otp == "" {
+			return "", trace.NotFound("environment variable %v is not set", envVar)
+		}
+		return otp, nil
+	}
+}
+
+// FileOTPProvider returns an OTPProvider that reads the code from the first
+// line of the given file. The file is read when the challenge is prompted, so
+// an external process may keep it updated with fresh codes.
+func FileOTPProvider(path string) OTPProvider {
+	return func(ctx context.Context) (string, error) {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return "", trace.ConvertSystemError(err)
+		}
+		otp, _, _ := strings.Cut(string(data), "\n")
+		if otp = strings.TrimSpace(otp); otp == "" {
+			return "", trace.NotFound("file %v contains no OTP code", path)
+		}
+		return otp, nil
+	}
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2022 Gravitational, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mfa
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gravitational/trace"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnvOTPProvider(t *testing.T) {
+	const envVar = "TELEPORT_TEST_OTP"
+	ctx := context.Background()
+	provider := EnvOTPProvider(envVar)
+
+	// The variable is read per call, not when the provider is built.
+	t.Setenv(envVar, " 123456 ")
+	otp, err := provider(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "123456", otp)
+
+	t.Setenv(envVar, "654321")
+	otp, err = provider(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "654321", otp)
+
+	t.Setenv(envVar, "")
+	_, err = provider(ctx)
+	require.True(t, trace.IsNotFound(err))
+}
+
+func TestFileOTPProvider(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	path := filepath.Join(t.TempDir(), "otp")
+	provider := FileOTPProvider(path)
+
+	// Missing file.
+	_, err := provider(ctx)
+	require.Error(t, err)
+
+	// Only the first line is used, surrounding whitespace is trimmed.
+	require.NoError(t, os.WriteFile(path, []byte(" 123456 \n777777\n"), 0600))
+	otp, err := provider(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "123456", otp)
+
+	// The file is re-read per call, so rotated codes are picked up.
+	require.NoError(t, os.WriteFile(path, []byte("654321\n"), 0600))
+	otp, err = provider(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "654321", otp)
+
+	// Empty file.
+	require.NoError(t, os.WriteFile(path, []byte("\n"), 0600))
+	_, err = provider(ctx)
+	require.True(t, trace.IsNotFound(err))
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2022 Gravitational, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mfa
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/gravitational/trace"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/gravitational/teleport/api/client/proto"
+)
+
+// defaultSSOPollInterval is how often an SSO MFA ceremony polls for
+// completion unless configured otherwise.
+const defaultSSOPollInterval = time.Second
+
+// SSOMFACeremony drives an IdP-backed MFA flow: it opens the IdP
+// authentication URL in the user's browser and polls until the IdP confirms
+// the check. It is raced against OTP and Webauthn prompts by CLIPrompt, so
+// whichever method the user completes first wins.
+type SSOMFACeremony struct {
+	// GetRedirectURL returns the IdP URL the user must visit for the given
+	// challenge, along with an opaque request ID used to poll for completion.
+	GetRedirectURL func(ctx context.Context, chal *proto.MFAAuthenticateChallenge) (redirectURL, requestID string, err error)
+	// OpenBrowser opens the given URL in the user's browser. If nil or
+	// failing, the URL is still printed so the user can follow it manually.
+	OpenBrowser func(url string) error
+	// Poll reports whether the ceremony identified by requestID has
+	// completed. A nil response with a nil error means the ceremony is still
+	// pending and Poll will be called again after PollInterval.
+	Poll func(ctx context.Context, requestID string) (*proto.MFAAuthenticateResponse, error)
+	// PollInterval overrides how often Poll is called. Defaults to
+	// defaultSSOPollInterval.
+	PollInterval time.Duration
+	// Messages overrides the user-facing ceremony strings. Empty fields
+	// fall back to DefaultMessages.
+	Messages *Messages
+}
+
+// CheckAndSetDefaults validates the ceremony configuration.
+func (c *SSOMFACeremony) CheckAndSetDefaults() error {
+	if c.GetRedirectURL == nil {
+		return trace.BadParameter("missing GetRedirectURL")
+	}
+	if c.Poll == nil {
+		return trace.BadParameter("missing Poll")
+	}
+	if c.PollInterval <= 0 {
+		c.PollInterval = defaultSSOPollInterval
+	}
+	return nil
+}
+
+// Run performs the SSO MFA ceremony, blocking until the IdP confirms the
+// authentication or the context is canceled. Progress messages are written to
+// out, which may be nil.
+func (c *SSOMFACeremony) Run(ctx context.Context, chal *proto.MFAAuthenticateChallenge, out io.Writer) (*proto.MFAAuthenticateResponse, error) {
+	if err := c.CheckAndSetDefaults(); err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	redirectURL, requestID, err := c.GetRedirectURL(ctx, chal)
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	opened := false
+	if c.OpenBrowser != nil {
+		if err := c.OpenBrowser(redirectURL); err != nil {
+			log.WithError(err).Debug("SSO MFA: failed to open browser")
+		} else {
+			opened = true
+		}
+	}
+	if out != nil {
+		msgs := c.Messages.withDefaults()
+		if opened {
+			fmt.Fprintf(out, msgs.FollowSSOLinkBrowserOpened+"\n", redirectURL)
+		} else {
+			fmt.Fprintf(out, msgs.FollowSSOLink+"\n", redirectURL)
+		}
+	}
+
+	ticker := time.NewTicker(c.PollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, trace.Wrap(ctx.Err())
+		case <-ticker.C:
+		}
+
+		resp, err := c.Poll(ctx, requestID)
+		if err != nil {
+			return nil, trace.Wrap(err)
+		}
+		if resp != nil {
+			return resp, nil
+		}
+	}
+}
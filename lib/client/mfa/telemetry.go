@@ -0,0 +1,91 @@
+/*
+Copyright 2022 Gravitational, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mfa
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// AttemptOutcome is the result of a single MFA attempt.
+type AttemptOutcome string
+
+const (
+	// OutcomeSuccess means the attempt satisfied the challenge.
+	OutcomeSuccess AttemptOutcome = "success"
+	// OutcomeFailure means the attempt failed.
+	OutcomeFailure AttemptOutcome = "failure"
+	// OutcomeCanceled means the ceremony was canceled or timed out before
+	// any method completed.
+	OutcomeCanceled AttemptOutcome = "canceled"
+)
+
+// Attempt describes a single MFA attempt, for telemetry hooks.
+type Attempt struct {
+	// Method is the MFA method attempted. Empty for ceremony-wide
+	// outcomes, such as cancellations.
+	Method Method
+	// Duration is how long the attempt took, measured from the start of
+	// the ceremony.
+	Duration time.Duration
+	// Outcome is the attempt result.
+	Outcome AttemptOutcome
+	// Error is the failure message, if any.
+	Error string
+}
+
+// TelemetryHook receives a record of every MFA attempt, so local security
+// telemetry (eg, osquery or endpoint agents) can observe MFA usage without
+// patching the prompt code. Hooks are invoked synchronously and must not
+// block.
+type TelemetryHook interface {
+	// RecordAttempt records a single MFA attempt.
+	RecordAttempt(ctx context.Context, attempt Attempt)
+}
+
+// TelemetryHookFunc is a function wrapper that implements the TelemetryHook
+// interface.
+type TelemetryHookFunc func(ctx context.Context, attempt Attempt)
+
+// RecordAttempt records a single MFA attempt.
+func (f TelemetryHookFunc) RecordAttempt(ctx context.Context, attempt Attempt) {
+	f(ctx, attempt)
+}
+
+var (
+	telemetryHooksMu sync.RWMutex
+	telemetryHooks   []TelemetryHook
+)
+
+// AddTelemetryHook registers a hook invoked on every MFA attempt made by
+// prompts in this process.
+func AddTelemetryHook(hook TelemetryHook) {
+	telemetryHooksMu.Lock()
+	defer telemetryHooksMu.Unlock()
+	telemetryHooks = append(telemetryHooks, hook)
+}
+
+// recordAttempt delivers the attempt to all registered hooks.
+func recordAttempt(ctx context.Context, attempt Attempt) {
+	telemetryHooksMu.RLock()
+	hooks := telemetryHooks
+	telemetryHooksMu.RUnlock()
+	for _, hook := range hooks {
+		hook.RecordAttempt(ctx, attempt)
+	}
+}
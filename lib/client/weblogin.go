@@ -218,7 +218,9 @@ type SSHLoginMFA struct {
 	Password string
 
 	// AllowStdinHijack allows stdin hijack during MFA prompts.
-	// Do not set this options unless you deeply understand what you are doing.
+	//
+	// Deprecated: concurrent prompts multiplex the shared stdin reader with
+	// cancellation, so the option is no longer needed and is ignored.
 	AllowStdinHijack bool
 	// AuthenticatorAttachment is the authenticator attachment for MFA prompts.
 	AuthenticatorAttachment wancli.AuthenticatorAttachment
@@ -945,6 +945,57 @@ func (set RoleSet) EnumerateDatabaseUsers(database types.Database, extraUsers ..
 	return result
 }
 
+// EnumerateDatabaseNames works on a given role set to return a minimal description of allowed set of database names.
+// It is biased towards *allowed* database names; It is meant to describe what the user can do, rather than cannot do.
+// For that reason if the user isn't allowed to pick *any* entities, the output will be empty.
+//
+// In cases where * is listed in set of allowed names, it may be hard for users to figure out the expected database name.
+// For this reason the parameter extraNames provides an extra set of names to be checked against RoleSet.
+// This extra set of names may be sourced e.g. from user connection history.
+func (set RoleSet) EnumerateDatabaseNames(database types.Database, extraNames ...string) EnumerationResult {
+	result := NewEnumerationResult()
+
+	// gather names for checking from the roles, check wildcards.
+	var names []string
+	for _, role := range set {
+		wildcardAllowed := false
+		wildcardDenied := false
+
+		for _, name := range role.GetDatabaseNames(types.Allow) {
+			if name == types.Wildcard {
+				wildcardAllowed = true
+			} else {
+				names = append(names, name)
+			}
+		}
+
+		for _, name := range role.GetDatabaseNames(types.Deny) {
+			if name == types.Wildcard {
+				wildcardDenied = true
+			} else {
+				names = append(names, name)
+			}
+		}
+
+		result.wildcardDenied = result.wildcardDenied || wildcardDenied
+
+		if err := NewRoleSet(role).checkAccess(database, AccessMFAParams{Verified: true}); err == nil {
+			result.wildcardAllowed = result.wildcardAllowed || wildcardAllowed
+		}
+
+	}
+
+	names = apiutils.Deduplicate(append(names, extraNames...))
+
+	// check each individual name against the database.
+	for _, name := range names {
+		err := set.checkAccess(database, AccessMFAParams{Verified: true}, &DatabaseNameMatcher{Name: name})
+		result.allowedDeniedMap[name] = err == nil
+	}
+
+	return result
+}
+
 // EnumerateServerLogins works on a given role set to return a minimal description of allowed set of logins.
 // The wildcard selector is ignored, since it is now allowed for server logins
 func (set RoleSet) EnumerateServerLogins(server types.Server) EnumerationResult {
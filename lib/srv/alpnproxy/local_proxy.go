@@ -23,6 +23,7 @@ import (
 	"net"
 	"net/http"
 	"net/http/httputil"
+	"sync"
 
 	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/gravitational/trace"
@@ -40,6 +41,9 @@ type LocalProxy struct {
 	cfg     LocalProxyConfig
 	context context.Context
 	cancel  context.CancelFunc
+	// certsMu guards access to cfg.Certs which can be swapped while the proxy is running, see
+	// SetCerts.
+	certsMu sync.RWMutex
 }
 
 // LocalProxyConfig is configuration for LocalProxy.
@@ -145,6 +149,21 @@ func (l *LocalProxy) GetAddr() string {
 	return l.cfg.Listener.Addr().String()
 }
 
+// SetCerts swaps the certificates used for upstream TLS connections. Connections established
+// before the swap keep using the old certificates.
+func (l *LocalProxy) SetCerts(certs []tls.Certificate) {
+	l.certsMu.Lock()
+	defer l.certsMu.Unlock()
+	l.cfg.Certs = certs
+}
+
+// getCerts returns the certificates used for upstream TLS connections.
+func (l *LocalProxy) getCerts() []tls.Certificate {
+	l.certsMu.RLock()
+	defer l.certsMu.RUnlock()
+	return l.cfg.Certs
+}
+
 // handleDownstreamConnection proxies the downstreamConn (connection established to the local proxy) and forward the
 // traffic to the upstreamConn (TLS connection to remote host).
 func (l *LocalProxy) handleDownstreamConnection(ctx context.Context, downstreamConn net.Conn) error {
@@ -156,7 +175,7 @@ func (l *LocalProxy) handleDownstreamConnection(ctx context.Context, downstreamC
 			NextProtos:         l.cfg.GetProtocols(),
 			InsecureSkipVerify: l.cfg.InsecureSkipVerify,
 			ServerName:         l.cfg.SNI,
-			Certificates:       l.cfg.Certs,
+			Certificates:       l.getCerts(),
 			RootCAs:            l.cfg.RootCAs,
 		},
 	})
@@ -191,7 +210,7 @@ func (l *LocalProxy) StartAWSAccessProxy(ctx context.Context) error {
 			NextProtos:         l.cfg.GetProtocols(),
 			InsecureSkipVerify: l.cfg.InsecureSkipVerify,
 			ServerName:         l.cfg.SNI,
-			Certificates:       l.cfg.Certs,
+			Certificates:       l.getCerts(),
 		},
 	}
 	proxy := &httputil.ReverseProxy{
@@ -0,0 +1,1248 @@
+// Copyright 2022 Gravitational, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        (unknown)
+// source: v1/tshd_events_service.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PromptMFARequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// cluster_uri is the URI of the cluster requiring the MFA check
+	ClusterUri string `protobuf:"bytes,1,opt,name=cluster_uri,json=clusterUri,proto3" json:"cluster_uri,omitempty"`
+	// webauthn is true when the challenge can be answered with a security key tap. The tap itself
+	// is handled by the daemon once the user picks this method
+	Webauthn bool `protobuf:"varint,2,opt,name=webauthn,proto3" json:"webauthn,omitempty"`
+	// totp is true when the challenge can be answered with an OTP code
+	Totp bool `protobuf:"varint,3,opt,name=totp,proto3" json:"totp,omitempty"`
+}
+
+func (x *PromptMFARequest) Reset() {
+	*x = PromptMFARequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_tshd_events_service_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PromptMFARequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromptMFARequest) ProtoMessage() {}
+
+func (x *PromptMFARequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_tshd_events_service_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromptMFARequest.ProtoReflect.Descriptor instead.
+func (*PromptMFARequest) Descriptor() ([]byte, []int) {
+	return file_v1_tshd_events_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PromptMFARequest) GetClusterUri() string {
+	if x != nil {
+		return x.ClusterUri
+	}
+	return ""
+}
+
+func (x *PromptMFARequest) GetWebauthn() bool {
+	if x != nil {
+		return x.Webauthn
+	}
+	return false
+}
+
+func (x *PromptMFARequest) GetTotp() bool {
+	if x != nil {
+		return x.Totp
+	}
+	return false
+}
+
+type PromptMFAResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// totp_code is the OTP code entered by the user. Empty when the user picked the security key
+	// method
+	TotpCode string `protobuf:"bytes,1,opt,name=totp_code,json=totpCode,proto3" json:"totp_code,omitempty"`
+}
+
+func (x *PromptMFAResponse) Reset() {
+	*x = PromptMFAResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_tshd_events_service_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PromptMFAResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromptMFAResponse) ProtoMessage() {}
+
+func (x *PromptMFAResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_tshd_events_service_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromptMFAResponse.ProtoReflect.Descriptor instead.
+func (*PromptMFAResponse) Descriptor() ([]byte, []int) {
+	return file_v1_tshd_events_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PromptMFAResponse) GetTotpCode() string {
+	if x != nil {
+		return x.TotpCode
+	}
+	return ""
+}
+
+type PromptHardwareKeyTouchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// cluster_uri is the URI of the cluster requiring the touch
+	ClusterUri string `protobuf:"bytes,1,opt,name=cluster_uri,json=clusterUri,proto3" json:"cluster_uri,omitempty"`
+}
+
+func (x *PromptHardwareKeyTouchRequest) Reset() {
+	*x = PromptHardwareKeyTouchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_tshd_events_service_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PromptHardwareKeyTouchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromptHardwareKeyTouchRequest) ProtoMessage() {}
+
+func (x *PromptHardwareKeyTouchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_tshd_events_service_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromptHardwareKeyTouchRequest.ProtoReflect.Descriptor instead.
+func (*PromptHardwareKeyTouchRequest) Descriptor() ([]byte, []int) {
+	return file_v1_tshd_events_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PromptHardwareKeyTouchRequest) GetClusterUri() string {
+	if x != nil {
+		return x.ClusterUri
+	}
+	return ""
+}
+
+type PromptHardwareKeyTouchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PromptHardwareKeyTouchResponse) Reset() {
+	*x = PromptHardwareKeyTouchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_tshd_events_service_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PromptHardwareKeyTouchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromptHardwareKeyTouchResponse) ProtoMessage() {}
+
+func (x *PromptHardwareKeyTouchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_tshd_events_service_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromptHardwareKeyTouchResponse.ProtoReflect.Descriptor instead.
+func (*PromptHardwareKeyTouchResponse) Descriptor() ([]byte, []int) {
+	return file_v1_tshd_events_service_proto_rawDescGZIP(), []int{3}
+}
+
+type PromptHardwareKeyPINRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// cluster_uri is the URI of the cluster requiring the PIN
+	ClusterUri string `protobuf:"bytes,1,opt,name=cluster_uri,json=clusterUri,proto3" json:"cluster_uri,omitempty"`
+}
+
+func (x *PromptHardwareKeyPINRequest) Reset() {
+	*x = PromptHardwareKeyPINRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_tshd_events_service_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PromptHardwareKeyPINRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromptHardwareKeyPINRequest) ProtoMessage() {}
+
+func (x *PromptHardwareKeyPINRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_tshd_events_service_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromptHardwareKeyPINRequest.ProtoReflect.Descriptor instead.
+func (*PromptHardwareKeyPINRequest) Descriptor() ([]byte, []int) {
+	return file_v1_tshd_events_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PromptHardwareKeyPINRequest) GetClusterUri() string {
+	if x != nil {
+		return x.ClusterUri
+	}
+	return ""
+}
+
+type PromptHardwareKeyPINResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// pin is the PIN entered by the user
+	Pin string `protobuf:"bytes,1,opt,name=pin,proto3" json:"pin,omitempty"`
+}
+
+func (x *PromptHardwareKeyPINResponse) Reset() {
+	*x = PromptHardwareKeyPINResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_tshd_events_service_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PromptHardwareKeyPINResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromptHardwareKeyPINResponse) ProtoMessage() {}
+
+func (x *PromptHardwareKeyPINResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_tshd_events_service_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromptHardwareKeyPINResponse.ProtoReflect.Descriptor instead.
+func (*PromptHardwareKeyPINResponse) Descriptor() ([]byte, []int) {
+	return file_v1_tshd_events_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PromptHardwareKeyPINResponse) GetPin() string {
+	if x != nil {
+		return x.Pin
+	}
+	return ""
+}
+
+type SendNotificationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// cluster_uri is the URI of the cluster the notification concerns
+	ClusterUri string `protobuf:"bytes,1,opt,name=cluster_uri,json=clusterUri,proto3" json:"cluster_uri,omitempty"`
+	// Types that are assignable to Subject:
+	//
+	//	*SendNotificationRequest_AccessRequestUpdate_
+	//	*SendNotificationRequest_CertExpiry_
+	Subject isSendNotificationRequest_Subject `protobuf_oneof:"subject"`
+}
+
+func (x *SendNotificationRequest) Reset() {
+	*x = SendNotificationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_tshd_events_service_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendNotificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendNotificationRequest) ProtoMessage() {}
+
+func (x *SendNotificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_tshd_events_service_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendNotificationRequest.ProtoReflect.Descriptor instead.
+func (*SendNotificationRequest) Descriptor() ([]byte, []int) {
+	return file_v1_tshd_events_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SendNotificationRequest) GetClusterUri() string {
+	if x != nil {
+		return x.ClusterUri
+	}
+	return ""
+}
+
+func (m *SendNotificationRequest) GetSubject() isSendNotificationRequest_Subject {
+	if m != nil {
+		return m.Subject
+	}
+	return nil
+}
+
+func (x *SendNotificationRequest) GetAccessRequestUpdate() *SendNotificationRequest_AccessRequestUpdate {
+	if x, ok := x.GetSubject().(*SendNotificationRequest_AccessRequestUpdate_); ok {
+		return x.AccessRequestUpdate
+	}
+	return nil
+}
+
+func (x *SendNotificationRequest) GetCertExpiry() *SendNotificationRequest_CertExpiry {
+	if x, ok := x.GetSubject().(*SendNotificationRequest_CertExpiry_); ok {
+		return x.CertExpiry
+	}
+	return nil
+}
+
+type isSendNotificationRequest_Subject interface {
+	isSendNotificationRequest_Subject()
+}
+
+type SendNotificationRequest_AccessRequestUpdate_ struct {
+	AccessRequestUpdate *SendNotificationRequest_AccessRequestUpdate `protobuf:"bytes,2,opt,name=access_request_update,json=accessRequestUpdate,proto3,oneof"`
+}
+
+type SendNotificationRequest_CertExpiry_ struct {
+	CertExpiry *SendNotificationRequest_CertExpiry `protobuf:"bytes,3,opt,name=cert_expiry,json=certExpiry,proto3,oneof"`
+}
+
+func (*SendNotificationRequest_AccessRequestUpdate_) isSendNotificationRequest_Subject() {}
+
+func (*SendNotificationRequest_CertExpiry_) isSendNotificationRequest_Subject() {}
+
+type SendNotificationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SendNotificationResponse) Reset() {
+	*x = SendNotificationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_tshd_events_service_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendNotificationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendNotificationResponse) ProtoMessage() {}
+
+func (x *SendNotificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_tshd_events_service_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendNotificationResponse.ProtoReflect.Descriptor instead.
+func (*SendNotificationResponse) Descriptor() ([]byte, []int) {
+	return file_v1_tshd_events_service_proto_rawDescGZIP(), []int{7}
+}
+
+type ReloginRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// root_cluster_uri is the URI of the root cluster the user has to log into again
+	RootClusterUri string `protobuf:"bytes,1,opt,name=root_cluster_uri,json=rootClusterUri,proto3" json:"root_cluster_uri,omitempty"`
+}
+
+func (x *ReloginRequest) Reset() {
+	*x = ReloginRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_tshd_events_service_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReloginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReloginRequest) ProtoMessage() {}
+
+func (x *ReloginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_tshd_events_service_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReloginRequest.ProtoReflect.Descriptor instead.
+func (*ReloginRequest) Descriptor() ([]byte, []int) {
+	return file_v1_tshd_events_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ReloginRequest) GetRootClusterUri() string {
+	if x != nil {
+		return x.RootClusterUri
+	}
+	return ""
+}
+
+type ReloginResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ReloginResponse) Reset() {
+	*x = ReloginResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_tshd_events_service_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReloginResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReloginResponse) ProtoMessage() {}
+
+func (x *ReloginResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_tshd_events_service_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReloginResponse.ProtoReflect.Descriptor instead.
+func (*ReloginResponse) Descriptor() ([]byte, []int) {
+	return file_v1_tshd_events_service_proto_rawDescGZIP(), []int{9}
+}
+
+// AccessRequestUpdate describes a state change of an access request concerning the user
+type SendNotificationRequest_AccessRequestUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// request_id is the ID of the access request
+	RequestId string `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// state is the new state of the request, e.g. "APPROVED"
+	State string `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+}
+
+func (x *SendNotificationRequest_AccessRequestUpdate) Reset() {
+	*x = SendNotificationRequest_AccessRequestUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_tshd_events_service_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendNotificationRequest_AccessRequestUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendNotificationRequest_AccessRequestUpdate) ProtoMessage() {}
+
+func (x *SendNotificationRequest_AccessRequestUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_tshd_events_service_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendNotificationRequest_AccessRequestUpdate.ProtoReflect.Descriptor instead.
+func (*SendNotificationRequest_AccessRequestUpdate) Descriptor() ([]byte, []int) {
+	return file_v1_tshd_events_service_proto_rawDescGZIP(), []int{6, 0}
+}
+
+func (x *SendNotificationRequest_AccessRequestUpdate) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *SendNotificationRequest_AccessRequestUpdate) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+// CertExpiry warns that the user certs expire soon
+type SendNotificationRequest_CertExpiry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// not_after is when the certs expire, in seconds of UTC Unix time
+	NotAfter int64 `protobuf:"varint,1,opt,name=not_after,json=notAfter,proto3" json:"not_after,omitempty"`
+	// assumed_roles is true when the certs carry roles assumed from access requests, so the
+	// expiry also means dropping the assumed roles
+	AssumedRoles bool `protobuf:"varint,2,opt,name=assumed_roles,json=assumedRoles,proto3" json:"assumed_roles,omitempty"`
+}
+
+func (x *SendNotificationRequest_CertExpiry) Reset() {
+	*x = SendNotificationRequest_CertExpiry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_tshd_events_service_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendNotificationRequest_CertExpiry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendNotificationRequest_CertExpiry) ProtoMessage() {}
+
+func (x *SendNotificationRequest_CertExpiry) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_tshd_events_service_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendNotificationRequest_CertExpiry.ProtoReflect.Descriptor instead.
+func (*SendNotificationRequest_CertExpiry) Descriptor() ([]byte, []int) {
+	return file_v1_tshd_events_service_proto_rawDescGZIP(), []int{6, 1}
+}
+
+func (x *SendNotificationRequest_CertExpiry) GetNotAfter() int64 {
+	if x != nil {
+		return x.NotAfter
+	}
+	return 0
+}
+
+func (x *SendNotificationRequest_CertExpiry) GetAssumedRoles() bool {
+	if x != nil {
+		return x.AssumedRoles
+	}
+	return false
+}
+
+var File_v1_tshd_events_service_proto protoreflect.FileDescriptor
+
+var file_v1_tshd_events_service_proto_rawDesc = []byte{
+	0x0a, 0x1c, 0x76, 0x31, 0x2f, 0x74, 0x73, 0x68, 0x64, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x14,
+	0x74, 0x65, 0x6c, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61,
+	0x6c, 0x2e, 0x76, 0x31, 0x22, 0x63, 0x0a, 0x10, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x4d, 0x46,
+	0x41, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x55, 0x72, 0x69, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x65, 0x62,
+	0x61, 0x75, 0x74, 0x68, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x77, 0x65, 0x62,
+	0x61, 0x75, 0x74, 0x68, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x6f, 0x74, 0x70, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x04, 0x74, 0x6f, 0x74, 0x70, 0x22, 0x30, 0x0a, 0x11, 0x50, 0x72, 0x6f,
+	0x6d, 0x70, 0x74, 0x4d, 0x46, 0x41, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b,
+	0x0a, 0x09, 0x74, 0x6f, 0x74, 0x70, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x74, 0x6f, 0x74, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x40, 0x0a, 0x1d, 0x50,
+	0x72, 0x6f, 0x6d, 0x70, 0x74, 0x48, 0x61, 0x72, 0x64, 0x77, 0x61, 0x72, 0x65, 0x4b, 0x65, 0x79,
+	0x54, 0x6f, 0x75, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x55, 0x72, 0x69, 0x22, 0x20, 0x0a,
+	0x1e, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x48, 0x61, 0x72, 0x64, 0x77, 0x61, 0x72, 0x65, 0x4b,
+	0x65, 0x79, 0x54, 0x6f, 0x75, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x3e, 0x0a, 0x1b, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x48, 0x61, 0x72, 0x64, 0x77, 0x61, 0x72,
+	0x65, 0x4b, 0x65, 0x79, 0x50, 0x49, 0x4e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x55, 0x72, 0x69, 0x22,
+	0x30, 0x0a, 0x1c, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x48, 0x61, 0x72, 0x64, 0x77, 0x61, 0x72,
+	0x65, 0x4b, 0x65, 0x79, 0x50, 0x49, 0x4e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x10, 0x0a, 0x03, 0x70, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x70, 0x69,
+	0x6e, 0x22, 0xb7, 0x03, 0x0a, 0x17, 0x53, 0x65, 0x6e, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a,
+	0x0b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x55, 0x72, 0x69, 0x12, 0x77,
+	0x0a, 0x15, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x41, 0x2e,
+	0x74, 0x65, 0x6c, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61,
+	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x41, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x48, 0x00, 0x52, 0x13, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x5b, 0x0a, 0x0b, 0x63, 0x65, 0x72, 0x74, 0x5f,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x74,
+	0x65, 0x6c, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x65, 0x72, 0x74,
+	0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x48, 0x00, 0x52, 0x0a, 0x63, 0x65, 0x72, 0x74, 0x45, 0x78,
+	0x70, 0x69, 0x72, 0x79, 0x1a, 0x4a, 0x0a, 0x13, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x1a, 0x4e, 0x0a, 0x0a, 0x43, 0x65, 0x72, 0x74, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x12, 0x1b,
+	0x0a, 0x09, 0x6e, 0x6f, 0x74, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x6e, 0x6f, 0x74, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x61,
+	0x73, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0c, 0x61, 0x73, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x52, 0x6f, 0x6c, 0x65, 0x73,
+	0x42, 0x09, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x1a, 0x0a, 0x18, 0x53,
+	0x65, 0x6e, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3a, 0x0a, 0x0e, 0x52, 0x65, 0x6c, 0x6f, 0x67,
+	0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x72, 0x6f, 0x6f,
+	0x74, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x6f, 0x6f, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x55, 0x72, 0x69, 0x22, 0x11, 0x0a, 0x0f, 0x52, 0x65, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xc1, 0x04, 0x0a, 0x11, 0x54, 0x73, 0x68, 0x64, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5c, 0x0a, 0x09,
+	0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x4d, 0x46, 0x41, 0x12, 0x26, 0x2e, 0x74, 0x65, 0x6c, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x2e, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x4d, 0x46, 0x41, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x27, 0x2e, 0x74, 0x65, 0x6c, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x74, 0x65, 0x72,
+	0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x4d,
+	0x46, 0x41, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x83, 0x01, 0x0a, 0x16, 0x50,
+	0x72, 0x6f, 0x6d, 0x70, 0x74, 0x48, 0x61, 0x72, 0x64, 0x77, 0x61, 0x72, 0x65, 0x4b, 0x65, 0x79,
+	0x54, 0x6f, 0x75, 0x63, 0x68, 0x12, 0x33, 0x2e, 0x74, 0x65, 0x6c, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x2e, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f,
+	0x6d, 0x70, 0x74, 0x48, 0x61, 0x72, 0x64, 0x77, 0x61, 0x72, 0x65, 0x4b, 0x65, 0x79, 0x54, 0x6f,
+	0x75, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x74, 0x65, 0x6c,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x48, 0x61, 0x72, 0x64, 0x77, 0x61, 0x72, 0x65,
+	0x4b, 0x65, 0x79, 0x54, 0x6f, 0x75, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x7d, 0x0a, 0x14, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x48, 0x61, 0x72, 0x64, 0x77, 0x61,
+	0x72, 0x65, 0x4b, 0x65, 0x79, 0x50, 0x49, 0x4e, 0x12, 0x31, 0x2e, 0x74, 0x65, 0x6c, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x2e, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x48, 0x61, 0x72, 0x64, 0x77, 0x61, 0x72, 0x65, 0x4b, 0x65,
+	0x79, 0x50, 0x49, 0x4e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x74, 0x65,
+	0x6c, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x48, 0x61, 0x72, 0x64, 0x77, 0x61, 0x72,
+	0x65, 0x4b, 0x65, 0x79, 0x50, 0x49, 0x4e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x71, 0x0a, 0x10, 0x53, 0x65, 0x6e, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x2e, 0x74, 0x65, 0x6c, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x74,
+	0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x74, 0x65, 0x6c, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x74, 0x65,
+	0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x56, 0x0a, 0x07, 0x52, 0x65, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x24, 0x2e,
+	0x74, 0x65, 0x6c, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61,
+	0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x74, 0x65, 0x6c, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x74,
+	0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6c, 0x6f, 0x67,
+	0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x33, 0x5a, 0x31, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x72, 0x61, 0x76, 0x69, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x2f, 0x74, 0x65, 0x6c, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2f,
+	0x6c, 0x69, 0x62, 0x2f, 0x74, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x72, 0x6d, 0x2f, 0x76, 0x31, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_v1_tshd_events_service_proto_rawDescOnce sync.Once
+	file_v1_tshd_events_service_proto_rawDescData = file_v1_tshd_events_service_proto_rawDesc
+)
+
+func file_v1_tshd_events_service_proto_rawDescGZIP() []byte {
+	file_v1_tshd_events_service_proto_rawDescOnce.Do(func() {
+		file_v1_tshd_events_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_v1_tshd_events_service_proto_rawDescData)
+	})
+	return file_v1_tshd_events_service_proto_rawDescData
+}
+
+var file_v1_tshd_events_service_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_v1_tshd_events_service_proto_goTypes = []interface{}{
+	(*PromptMFARequest)(nil),                            // 0: teleport.terminal.v1.PromptMFARequest
+	(*PromptMFAResponse)(nil),                           // 1: teleport.terminal.v1.PromptMFAResponse
+	(*PromptHardwareKeyTouchRequest)(nil),               // 2: teleport.terminal.v1.PromptHardwareKeyTouchRequest
+	(*PromptHardwareKeyTouchResponse)(nil),              // 3: teleport.terminal.v1.PromptHardwareKeyTouchResponse
+	(*PromptHardwareKeyPINRequest)(nil),                 // 4: teleport.terminal.v1.PromptHardwareKeyPINRequest
+	(*PromptHardwareKeyPINResponse)(nil),                // 5: teleport.terminal.v1.PromptHardwareKeyPINResponse
+	(*SendNotificationRequest)(nil),                     // 6: teleport.terminal.v1.SendNotificationRequest
+	(*SendNotificationResponse)(nil),                    // 7: teleport.terminal.v1.SendNotificationResponse
+	(*ReloginRequest)(nil),                              // 8: teleport.terminal.v1.ReloginRequest
+	(*ReloginResponse)(nil),                             // 9: teleport.terminal.v1.ReloginResponse
+	(*SendNotificationRequest_AccessRequestUpdate)(nil), // 10: teleport.terminal.v1.SendNotificationRequest.AccessRequestUpdate
+	(*SendNotificationRequest_CertExpiry)(nil),          // 11: teleport.terminal.v1.SendNotificationRequest.CertExpiry
+}
+var file_v1_tshd_events_service_proto_depIdxs = []int32{
+	10, // 0: teleport.terminal.v1.SendNotificationRequest.access_request_update:type_name -> teleport.terminal.v1.SendNotificationRequest.AccessRequestUpdate
+	11, // 1: teleport.terminal.v1.SendNotificationRequest.cert_expiry:type_name -> teleport.terminal.v1.SendNotificationRequest.CertExpiry
+	0,  // 2: teleport.terminal.v1.TshdEventsService.PromptMFA:input_type -> teleport.terminal.v1.PromptMFARequest
+	2,  // 3: teleport.terminal.v1.TshdEventsService.PromptHardwareKeyTouch:input_type -> teleport.terminal.v1.PromptHardwareKeyTouchRequest
+	4,  // 4: teleport.terminal.v1.TshdEventsService.PromptHardwareKeyPIN:input_type -> teleport.terminal.v1.PromptHardwareKeyPINRequest
+	6,  // 5: teleport.terminal.v1.TshdEventsService.SendNotification:input_type -> teleport.terminal.v1.SendNotificationRequest
+	8,  // 6: teleport.terminal.v1.TshdEventsService.Relogin:input_type -> teleport.terminal.v1.ReloginRequest
+	1,  // 7: teleport.terminal.v1.TshdEventsService.PromptMFA:output_type -> teleport.terminal.v1.PromptMFAResponse
+	3,  // 8: teleport.terminal.v1.TshdEventsService.PromptHardwareKeyTouch:output_type -> teleport.terminal.v1.PromptHardwareKeyTouchResponse
+	5,  // 9: teleport.terminal.v1.TshdEventsService.PromptHardwareKeyPIN:output_type -> teleport.terminal.v1.PromptHardwareKeyPINResponse
+	7,  // 10: teleport.terminal.v1.TshdEventsService.SendNotification:output_type -> teleport.terminal.v1.SendNotificationResponse
+	9,  // 11: teleport.terminal.v1.TshdEventsService.Relogin:output_type -> teleport.terminal.v1.ReloginResponse
+	7,  // [7:12] is the sub-list for method output_type
+	2,  // [2:7] is the sub-list for method input_type
+	2,  // [2:2] is the sub-list for extension type_name
+	2,  // [2:2] is the sub-list for extension extendee
+	0,  // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_v1_tshd_events_service_proto_init() }
+func file_v1_tshd_events_service_proto_init() {
+	if File_v1_tshd_events_service_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_v1_tshd_events_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PromptMFARequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_tshd_events_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PromptMFAResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_tshd_events_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PromptHardwareKeyTouchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_tshd_events_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PromptHardwareKeyTouchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_tshd_events_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PromptHardwareKeyPINRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_tshd_events_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PromptHardwareKeyPINResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_tshd_events_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendNotificationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_tshd_events_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendNotificationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_tshd_events_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReloginRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_tshd_events_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReloginResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_tshd_events_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendNotificationRequest_AccessRequestUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_tshd_events_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendNotificationRequest_CertExpiry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_v1_tshd_events_service_proto_msgTypes[6].OneofWrappers = []interface{}{
+		(*SendNotificationRequest_AccessRequestUpdate_)(nil),
+		(*SendNotificationRequest_CertExpiry_)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_v1_tshd_events_service_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_v1_tshd_events_service_proto_goTypes,
+		DependencyIndexes: file_v1_tshd_events_service_proto_depIdxs,
+		MessageInfos:      file_v1_tshd_events_service_proto_msgTypes,
+	}.Build()
+	File_v1_tshd_events_service_proto = out.File
+	file_v1_tshd_events_service_proto_rawDesc = nil
+	file_v1_tshd_events_service_proto_goTypes = nil
+	file_v1_tshd_events_service_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// TshdEventsServiceClient is the client API for TshdEventsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type TshdEventsServiceClient interface {
+	// PromptMFA notifies the app that a connection is waiting on a per-session MFA check. The app
+	// shows the prompt and returns the user's response; the daemon blocks the connection until the
+	// call returns or its context is canceled
+	PromptMFA(ctx context.Context, in *PromptMFARequest, opts ...grpc.CallOption) (*PromptMFAResponse, error)
+	// PromptHardwareKeyTouch notifies the app that an operation is waiting for a touch on the
+	// hardware key. The app shows the prompt and returns once it is dismissed; the touch itself is
+	// detected by the daemon
+	PromptHardwareKeyTouch(ctx context.Context, in *PromptHardwareKeyTouchRequest, opts ...grpc.CallOption) (*PromptHardwareKeyTouchResponse, error)
+	// PromptHardwareKeyPIN asks the user for the PIN of the hardware key
+	PromptHardwareKeyPIN(ctx context.Context, in *PromptHardwareKeyPINRequest, opts ...grpc.CallOption) (*PromptHardwareKeyPINResponse, error)
+	// SendNotification tells the app to show a toast for a daemon-originated event, e.g. an access
+	// request changing state or the user certs being about to expire. Unlike the prompts, the
+	// daemon does not wait for the user to react
+	SendNotification(ctx context.Context, in *SendNotificationRequest, opts ...grpc.CallOption) (*SendNotificationResponse, error)
+	// Relogin notifies the app that an operation failed because the user certs expired. The app
+	// opens the login flow for the given root cluster and returns once the user completes it; the
+	// daemon then retries the original operation transparently. Returning an error means the user
+	// dismissed the login flow and the original error should be surfaced
+	Relogin(ctx context.Context, in *ReloginRequest, opts ...grpc.CallOption) (*ReloginResponse, error)
+}
+
+type tshdEventsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTshdEventsServiceClient(cc grpc.ClientConnInterface) TshdEventsServiceClient {
+	return &tshdEventsServiceClient{cc}
+}
+
+func (c *tshdEventsServiceClient) PromptMFA(ctx context.Context, in *PromptMFARequest, opts ...grpc.CallOption) (*PromptMFAResponse, error) {
+	out := new(PromptMFAResponse)
+	err := c.cc.Invoke(ctx, "/teleport.terminal.v1.TshdEventsService/PromptMFA", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tshdEventsServiceClient) PromptHardwareKeyTouch(ctx context.Context, in *PromptHardwareKeyTouchRequest, opts ...grpc.CallOption) (*PromptHardwareKeyTouchResponse, error) {
+	out := new(PromptHardwareKeyTouchResponse)
+	err := c.cc.Invoke(ctx, "/teleport.terminal.v1.TshdEventsService/PromptHardwareKeyTouch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tshdEventsServiceClient) PromptHardwareKeyPIN(ctx context.Context, in *PromptHardwareKeyPINRequest, opts ...grpc.CallOption) (*PromptHardwareKeyPINResponse, error) {
+	out := new(PromptHardwareKeyPINResponse)
+	err := c.cc.Invoke(ctx, "/teleport.terminal.v1.TshdEventsService/PromptHardwareKeyPIN", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tshdEventsServiceClient) SendNotification(ctx context.Context, in *SendNotificationRequest, opts ...grpc.CallOption) (*SendNotificationResponse, error) {
+	out := new(SendNotificationResponse)
+	err := c.cc.Invoke(ctx, "/teleport.terminal.v1.TshdEventsService/SendNotification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tshdEventsServiceClient) Relogin(ctx context.Context, in *ReloginRequest, opts ...grpc.CallOption) (*ReloginResponse, error) {
+	out := new(ReloginResponse)
+	err := c.cc.Invoke(ctx, "/teleport.terminal.v1.TshdEventsService/Relogin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TshdEventsServiceServer is the server API for TshdEventsService service.
+type TshdEventsServiceServer interface {
+	// PromptMFA notifies the app that a connection is waiting on a per-session MFA check. The app
+	// shows the prompt and returns the user's response; the daemon blocks the connection until the
+	// call returns or its context is canceled
+	PromptMFA(context.Context, *PromptMFARequest) (*PromptMFAResponse, error)
+	// PromptHardwareKeyTouch notifies the app that an operation is waiting for a touch on the
+	// hardware key. The app shows the prompt and returns once it is dismissed; the touch itself is
+	// detected by the daemon
+	PromptHardwareKeyTouch(context.Context, *PromptHardwareKeyTouchRequest) (*PromptHardwareKeyTouchResponse, error)
+	// PromptHardwareKeyPIN asks the user for the PIN of the hardware key
+	PromptHardwareKeyPIN(context.Context, *PromptHardwareKeyPINRequest) (*PromptHardwareKeyPINResponse, error)
+	// SendNotification tells the app to show a toast for a daemon-originated event, e.g. an access
+	// request changing state or the user certs being about to expire. Unlike the prompts, the
+	// daemon does not wait for the user to react
+	SendNotification(context.Context, *SendNotificationRequest) (*SendNotificationResponse, error)
+	// Relogin notifies the app that an operation failed because the user certs expired. The app
+	// opens the login flow for the given root cluster and returns once the user completes it; the
+	// daemon then retries the original operation transparently. Returning an error means the user
+	// dismissed the login flow and the original error should be surfaced
+	Relogin(context.Context, *ReloginRequest) (*ReloginResponse, error)
+}
+
+// UnimplementedTshdEventsServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedTshdEventsServiceServer struct {
+}
+
+func (*UnimplementedTshdEventsServiceServer) PromptMFA(context.Context, *PromptMFARequest) (*PromptMFAResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PromptMFA not implemented")
+}
+func (*UnimplementedTshdEventsServiceServer) PromptHardwareKeyTouch(context.Context, *PromptHardwareKeyTouchRequest) (*PromptHardwareKeyTouchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PromptHardwareKeyTouch not implemented")
+}
+func (*UnimplementedTshdEventsServiceServer) PromptHardwareKeyPIN(context.Context, *PromptHardwareKeyPINRequest) (*PromptHardwareKeyPINResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PromptHardwareKeyPIN not implemented")
+}
+func (*UnimplementedTshdEventsServiceServer) SendNotification(context.Context, *SendNotificationRequest) (*SendNotificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendNotification not implemented")
+}
+func (*UnimplementedTshdEventsServiceServer) Relogin(context.Context, *ReloginRequest) (*ReloginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Relogin not implemented")
+}
+
+func RegisterTshdEventsServiceServer(s *grpc.Server, srv TshdEventsServiceServer) {
+	s.RegisterService(&_TshdEventsService_serviceDesc, srv)
+}
+
+func _TshdEventsService_PromptMFA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PromptMFARequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TshdEventsServiceServer).PromptMFA(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/teleport.terminal.v1.TshdEventsService/PromptMFA",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TshdEventsServiceServer).PromptMFA(ctx, req.(*PromptMFARequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TshdEventsService_PromptHardwareKeyTouch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PromptHardwareKeyTouchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TshdEventsServiceServer).PromptHardwareKeyTouch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/teleport.terminal.v1.TshdEventsService/PromptHardwareKeyTouch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TshdEventsServiceServer).PromptHardwareKeyTouch(ctx, req.(*PromptHardwareKeyTouchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TshdEventsService_PromptHardwareKeyPIN_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PromptHardwareKeyPINRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TshdEventsServiceServer).PromptHardwareKeyPIN(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/teleport.terminal.v1.TshdEventsService/PromptHardwareKeyPIN",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TshdEventsServiceServer).PromptHardwareKeyPIN(ctx, req.(*PromptHardwareKeyPINRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TshdEventsService_SendNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendNotificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TshdEventsServiceServer).SendNotification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/teleport.terminal.v1.TshdEventsService/SendNotification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TshdEventsServiceServer).SendNotification(ctx, req.(*SendNotificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TshdEventsService_Relogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReloginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TshdEventsServiceServer).Relogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/teleport.terminal.v1.TshdEventsService/Relogin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TshdEventsServiceServer).Relogin(ctx, req.(*ReloginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _TshdEventsService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "teleport.terminal.v1.TshdEventsService",
+	HandlerType: (*TshdEventsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PromptMFA",
+			Handler:    _TshdEventsService_PromptMFA_Handler,
+		},
+		{
+			MethodName: "PromptHardwareKeyTouch",
+			Handler:    _TshdEventsService_PromptHardwareKeyTouch_Handler,
+		},
+		{
+			MethodName: "PromptHardwareKeyPIN",
+			Handler:    _TshdEventsService_PromptHardwareKeyPIN_Handler,
+		},
+		{
+			MethodName: "SendNotification",
+			Handler:    _TshdEventsService_SendNotification_Handler,
+		},
+		{
+			MethodName: "Relogin",
+			Handler:    _TshdEventsService_Relogin_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "v1/tshd_events_service.proto",
+}
@@ -25,6 +25,10 @@ import (
 
 var pathClusters = urlpath.New("/clusters/:cluster/*")
 var pathLeafClusters = urlpath.New("/clusters/:cluster/leaves/:leaf/*")
+var pathKubes = urlpath.New("/clusters/:cluster/kubes/:kube/*")
+var pathLeafKubes = urlpath.New("/clusters/:cluster/leaves/:leaf/kubes/:kube/*")
+var pathApps = urlpath.New("/clusters/:cluster/apps/:app/*")
+var pathLeafApps = urlpath.New("/clusters/:cluster/leaves/:leaf/apps/:app/*")
 
 // New creates an instance of ResourceURI
 func New(path string) ResourceURI {
@@ -92,6 +96,38 @@ func (r ResourceURI) GetLeafClusterName() string {
 	return result.Params["leaf"]
 }
 
+// GetKubeName extracts the kube name from the URI. Returns an empty string if the URI is not a
+// kube URI.
+func (r ResourceURI) GetKubeName() string {
+	result, ok := pathLeafKubes.Match(r.path + "/")
+	if ok {
+		return result.Params["kube"]
+	}
+
+	result, ok = pathKubes.Match(r.path + "/")
+	if ok {
+		return result.Params["kube"]
+	}
+
+	return ""
+}
+
+// GetAppName extracts the app name from the URI. Returns an empty string if the URI is not an
+// app URI.
+func (r ResourceURI) GetAppName() string {
+	result, ok := pathLeafApps.Match(r.path + "/")
+	if ok {
+		return result.Params["app"]
+	}
+
+	result, ok = pathApps.Match(r.path + "/")
+	if ok {
+		return result.Params["app"]
+	}
+
+	return ""
+}
+
 // AppendServer appends server segment to the URI
 func (r ResourceURI) AppendServer(id string) ResourceURI {
 	r.path = fmt.Sprintf("%v/servers/%v", r.path, id)
@@ -128,6 +164,12 @@ func (r ResourceURI) AppendApp(name string) ResourceURI {
 	return r
 }
 
+// AppendDesktop appends windows desktop segment to the URI
+func (r ResourceURI) AppendDesktop(name string) ResourceURI {
+	r.path = fmt.Sprintf("%v/desktops/%v", r.path, name)
+	return r
+}
+
 // String returns string representation of the Resource URI
 func (r ResourceURI) String() string {
 	return r.path
@@ -91,3 +91,73 @@ func TestParseClusterURI(t *testing.T) {
 		})
 	}
 }
+
+func TestGetKubeName(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		in  string
+		out string
+	}{
+		{
+			"/clusters/cluster.sh/kubes/kube1",
+			"kube1",
+		},
+		{
+			"/clusters/cluster.sh/leaves/leaf.sh/kubes/kube2",
+			"kube2",
+		},
+		{
+			"/clusters/cluster.sh/dbs/postgres",
+			"",
+		},
+		{
+			"/clusters/cluster.sh",
+			"",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.in, func(t *testing.T) {
+			t.Parallel()
+
+			out := uri.New(tt.in).GetKubeName()
+			require.Equal(t, tt.out, out)
+		})
+	}
+}
+
+func TestGetAppName(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		in  string
+		out string
+	}{
+		{
+			"/clusters/cluster.sh/apps/app1",
+			"app1",
+		},
+		{
+			"/clusters/cluster.sh/leaves/leaf.sh/apps/app2",
+			"app2",
+		},
+		{
+			"/clusters/cluster.sh/kubes/kube1",
+			"",
+		},
+		{
+			"/clusters/cluster.sh",
+			"",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.in, func(t *testing.T) {
+			t.Parallel()
+
+			out := uri.New(tt.in).GetAppName()
+			require.Equal(t, tt.out, out)
+		})
+	}
+}
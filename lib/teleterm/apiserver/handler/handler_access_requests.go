@@ -0,0 +1,123 @@
+// Copyright 2022 Gravitational, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"context"
+	"time"
+
+	"github.com/gravitational/teleport/api/types"
+	api "github.com/gravitational/teleport/lib/teleterm/api/protogen/golang/v1"
+	"github.com/gravitational/teleport/lib/teleterm/clusters"
+
+	"github.com/gravitational/trace"
+)
+
+// GetAccessRequests lists the access requests of a cluster
+func (s *Handler) GetAccessRequests(ctx context.Context, req *api.GetAccessRequestsRequest) (*api.GetAccessRequestsResponse, error) {
+	requests, err := s.DaemonService.GetAccessRequests(ctx, req.ClusterUri, types.AccessRequestFilter{
+		ID: req.Id,
+	})
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	response := &api.GetAccessRequestsResponse{}
+	for _, request := range requests {
+		response.Requests = append(response.Requests, newAPIAccessRequest(request))
+	}
+
+	return response, nil
+}
+
+// CreateAccessRequest creates an access request for the logged-in user
+func (s *Handler) CreateAccessRequest(ctx context.Context, req *api.CreateAccessRequestRequest) (*api.AccessRequest, error) {
+	request, err := s.DaemonService.CreateAccessRequest(ctx, req.ClusterUri, clusters.CreateAccessRequestParams{
+		Reason:             req.Reason,
+		Roles:              req.Roles,
+		SuggestedReviewers: req.SuggestedReviewers,
+	})
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	return newAPIAccessRequest(request), nil
+}
+
+// ReviewAccessRequest submits a review of an access request
+func (s *Handler) ReviewAccessRequest(ctx context.Context, req *api.ReviewAccessRequestRequest) (*api.AccessRequest, error) {
+	state := types.RequestState_DENIED
+	if req.Approve {
+		state = types.RequestState_APPROVED
+	}
+
+	request, err := s.DaemonService.ReviewAccessRequest(ctx, req.ClusterUri, clusters.ReviewAccessRequestParams{
+		RequestID: req.RequestId,
+		State:     state,
+		Reason:    req.Reason,
+	})
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	return newAPIAccessRequest(request), nil
+}
+
+// DeleteAccessRequest removes an access request
+func (s *Handler) DeleteAccessRequest(ctx context.Context, req *api.DeleteAccessRequestRequest) (*api.EmptyResponse, error) {
+	if err := s.DaemonService.DeleteAccessRequest(ctx, req.ClusterUri, req.RequestId); err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	return &api.EmptyResponse{}, nil
+}
+
+// GetAccessCapabilities returns the requestable roles and the suggested reviewers of the
+// logged-in user
+func (s *Handler) GetAccessCapabilities(ctx context.Context, req *api.GetAccessCapabilitiesRequest) (*api.GetAccessCapabilitiesResponse, error) {
+	caps, err := s.DaemonService.GetAccessCapabilities(ctx, req.ClusterUri)
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	return &api.GetAccessCapabilitiesResponse{
+		RequestableRoles:   caps.RequestableRoles,
+		SuggestedReviewers: caps.SuggestedReviewers,
+	}, nil
+}
+
+// AssumeRoles reissues the user certs with the given approved access requests added and the
+// given requests dropped
+func (s *Handler) AssumeRoles(ctx context.Context, req *api.AssumeRolesRequest) (*api.EmptyResponse, error) {
+	if err := s.DaemonService.AssumeRoles(ctx, req.ClusterUri, req.RequestIds, req.DropRequestIds); err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	return &api.EmptyResponse{}, nil
+}
+
+func newAPIAccessRequest(request types.AccessRequest) *api.AccessRequest {
+	return &api.AccessRequest{
+		Id:                 request.GetName(),
+		State:              request.GetState().String(),
+		User:               request.GetUser(),
+		Roles:              request.GetRoles(),
+		Reason:             request.GetRequestReason(),
+		ResolveReason:      request.GetResolveReason(),
+		SuggestedReviewers: request.GetSuggestedReviewers(),
+		Created:            request.GetCreationTime().Format(time.RFC3339),
+		Expires:            request.Expiry().Format(time.RFC3339),
+	}
+}
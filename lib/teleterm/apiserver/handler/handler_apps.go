@@ -39,6 +39,19 @@ func (s *Handler) ListApps(ctx context.Context, req *api.ListAppsRequest) (*api.
 	return response, nil
 }
 
+// ResolveApp returns the loopback address allocated for the TCP app with the given public
+// hostname, allocating the address and creating a gateway bound to it on demand
+func (s *Handler) ResolveApp(ctx context.Context, req *api.ResolveAppRequest) (*api.ResolveAppResponse, error) {
+	localAddress, err := s.DaemonService.ResolveApp(ctx, req.Hostname)
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	return &api.ResolveAppResponse{
+		LocalAddress: localAddress,
+	}, nil
+}
+
 func newAPIApp(app clusters.App) *api.App {
 	apiLabels := APILabels{}
 	for name, value := range app.GetAllLabels() {
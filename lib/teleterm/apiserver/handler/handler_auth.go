@@ -41,7 +41,7 @@ func (s *Handler) Login(ctx context.Context, req *api.LoginRequest) (*api.EmptyR
 
 		return &api.EmptyResponse{}, nil
 	case *api.LoginRequest_Sso:
-		if err := cluster.SSOLogin(ctx, params.Sso.ProviderType, params.Sso.ProviderName); err != nil {
+		if err := cluster.SSOLogin(ctx, params.Sso.ProviderType, params.Sso.ProviderName, nil); err != nil {
 			return nil, trace.Wrap(err)
 		}
 
@@ -78,6 +78,23 @@ func (s *Handler) LoginPasswordless(stream api.TerminalService_LoginPasswordless
 	return nil
 }
 
+// LoginSSO performs an SSO login driven entirely by the daemon. The daemon starts the local
+// callback listener and streams back the IdP URL for the UI to open in the browser.
+func (s *Handler) LoginSSO(req *api.LoginSSORequest, stream api.TerminalService_LoginSSOServer) error {
+	cluster, err := s.DaemonService.ResolveCluster(req.ClusterUri)
+	if err != nil {
+		return trace.Wrap(err)
+	}
+
+	err = cluster.SSOLogin(stream.Context(), req.ProviderType, req.ProviderName, func(redirectURL string) {
+		// A failure to deliver the redirect URL cancels the stream context and thereby the
+		// whole login, no need to handle it here.
+		stream.Send(&api.LoginSSOResponse{RedirectUrl: redirectURL})
+	})
+
+	return trace.Wrap(err)
+}
+
 // Logout logs a user out from a cluster
 func (s *Handler) Logout(ctx context.Context, req *api.LogoutRequest) (*api.EmptyResponse, error) {
 	if err := s.DaemonService.ClusterLogout(ctx, req.ClusterUri); err != nil {
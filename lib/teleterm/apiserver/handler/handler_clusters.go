@@ -74,14 +74,57 @@ func (s *Handler) RemoveCluster(ctx context.Context, req *api.RemoveClusterReque
 	return &api.EmptyResponse{}, nil
 }
 
-// GetCluster returns a cluster
+// GetCluster returns a cluster with the auth settings, enabled features and the ACL of the
+// logged-in user, so the UI can hide functionality unavailable in the cluster
 func (s *Handler) GetCluster(ctx context.Context, req *api.GetClusterRequest) (*api.Cluster, error) {
-	cluster, err := s.DaemonService.ResolveCluster(req.ClusterUri)
+	details, err := s.DaemonService.GetClusterDetails(ctx, req.ClusterUri)
 	if err != nil {
 		return nil, trace.Wrap(err)
 	}
 
-	return newAPIRootCluster(cluster), nil
+	apiCluster := newAPIRootCluster(details.Cluster)
+	apiCluster.LoggedInUser.Acl = newAPIACL(details.ACL)
+	if details.Features != nil {
+		apiCluster.Features = &api.Features{
+			AdvancedAccessWorkflows: details.Features.AdvancedAccessWorkflows,
+			IsCloud:                 details.Features.Cloud,
+		}
+	}
+	if len(details.Traits) > 0 {
+		apiCluster.LoggedInUser.Traits = make(map[string]*api.LoggedInUser_TraitValues, len(details.Traits))
+		for trait, values := range details.Traits {
+			apiCluster.LoggedInUser.Traits[trait] = &api.LoggedInUser_TraitValues{Values: values}
+		}
+	}
+
+	return apiCluster, nil
+}
+
+func newAPIACL(acl *clusters.ACL) *api.ACL {
+	return &api.ACL{
+		Sessions:        newAPIResourceAccess(acl.Sessions),
+		AuthConnectors:  newAPIResourceAccess(acl.AuthConnectors),
+		Roles:           newAPIResourceAccess(acl.Roles),
+		Users:           newAPIResourceAccess(acl.Users),
+		TrustedClusters: newAPIResourceAccess(acl.TrustedClusters),
+		Events:          newAPIResourceAccess(acl.Events),
+		Tokens:          newAPIResourceAccess(acl.Tokens),
+		Servers:         newAPIResourceAccess(acl.Servers),
+		Apps:            newAPIResourceAccess(acl.Apps),
+		Dbs:             newAPIResourceAccess(acl.DBs),
+		Kubeservers:     newAPIResourceAccess(acl.Kubeservers),
+		AccessRequests:  newAPIResourceAccess(acl.AccessRequests),
+	}
+}
+
+func newAPIResourceAccess(access clusters.ResourceAccess) *api.ResourceAccess {
+	return &api.ResourceAccess{
+		List:   access.List,
+		Read:   access.Read,
+		Edit:   access.Edit,
+		Create: access.Create,
+		Delete: access.Delete,
+	}
 }
 
 func newAPIRootCluster(cluster *clusters.Cluster) *api.Cluster {
@@ -0,0 +1,62 @@
+// Copyright 2022 Gravitational, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"context"
+
+	api "github.com/gravitational/teleport/lib/teleterm/api/protogen/golang/v1"
+
+	"github.com/gravitational/trace"
+)
+
+// CreateConnectMyComputerRole creates a role giving the logged-in user access to their own
+// Connect My Computer node and assigns the role to the user
+func (s *Handler) CreateConnectMyComputerRole(ctx context.Context, req *api.CreateConnectMyComputerRoleRequest) (*api.CreateConnectMyComputerRoleResponse, error) {
+	role, err := s.DaemonService.CreateConnectMyComputerRole(ctx, req.ClusterUri)
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	return &api.CreateConnectMyComputerRoleResponse{
+		RoleName: role.GetName(),
+	}, nil
+}
+
+// CreateConnectMyComputerNodeToken creates a short-lived provision token the Connect My Computer
+// node agent uses to join the cluster
+func (s *Handler) CreateConnectMyComputerNodeToken(ctx context.Context, req *api.CreateConnectMyComputerNodeTokenRequest) (*api.CreateConnectMyComputerNodeTokenResponse, error) {
+	token, err := s.DaemonService.CreateConnectMyComputerNodeToken(ctx, req.ClusterUri)
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	return &api.CreateConnectMyComputerNodeTokenResponse{
+		Token: token,
+	}, nil
+}
+
+// WaitForConnectMyComputerNodeJoin blocks until a node owned by the logged-in user joins the
+// cluster, returning that node
+func (s *Handler) WaitForConnectMyComputerNodeJoin(ctx context.Context, req *api.WaitForConnectMyComputerNodeJoinRequest) (*api.WaitForConnectMyComputerNodeJoinResponse, error) {
+	server, err := s.DaemonService.WaitForConnectMyComputerNodeJoin(ctx, req.ClusterUri)
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	return &api.WaitForConnectMyComputerNodeJoinResponse{
+		Server: newAPIServer(*server),
+	}, nil
+}
@@ -26,12 +26,7 @@ import (
 
 // ListDatabases lists databases
 func (s *Handler) ListDatabases(ctx context.Context, req *api.ListDatabasesRequest) (*api.ListDatabasesResponse, error) {
-	cluster, err := s.DaemonService.ResolveCluster(req.ClusterUri)
-	if err != nil {
-		return nil, trace.Wrap(err)
-	}
-
-	dbs, err := cluster.GetDatabases(ctx)
+	dbs, err := s.DaemonService.ListDatabases(ctx, req.ClusterUri)
 	if err != nil {
 		return nil, trace.Wrap(err)
 	}
@@ -50,18 +45,14 @@ func (s *Handler) ListDatabases(ctx context.Context, req *api.ListDatabasesReque
 // The list is based on whatever we can deduce from the role set, so it's similar to the behavior of
 // `tsh db ls -v`, with the exception that Teleterm is interested only in the allowed usernames.
 func (s *Handler) ListDatabaseUsers(ctx context.Context, req *api.ListDatabaseUsersRequest) (*api.ListDatabaseUsersResponse, error) {
-	cluster, err := s.DaemonService.ResolveCluster(req.DbUri)
-	if err != nil {
-		return nil, trace.Wrap(err)
-	}
-
-	dbUsers, err := cluster.GetAllowedDatabaseUsers(ctx, req.DbUri)
+	dbUsers, err := s.DaemonService.GetAllowedDatabaseUsers(ctx, req.DbUri)
 	if err != nil {
 		return nil, trace.Wrap(err)
 	}
 
 	return &api.ListDatabaseUsersResponse{
-		Users: dbUsers,
+		Users:           dbUsers.Users,
+		WildcardAllowed: dbUsers.WildcardAllowed,
 	}, nil
 }
 
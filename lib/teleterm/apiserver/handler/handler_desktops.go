@@ -0,0 +1,74 @@
+// Copyright 2022 Gravitational, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"context"
+	"sort"
+
+	api "github.com/gravitational/teleport/lib/teleterm/api/protogen/golang/v1"
+	"github.com/gravitational/teleport/lib/teleterm/clusters"
+
+	"github.com/gravitational/trace"
+)
+
+// ListDesktops lists windows desktops of a cluster
+func (s *Handler) ListDesktops(ctx context.Context, req *api.ListDesktopsRequest) (*api.ListDesktopsResponse, error) {
+	desktops, err := s.DaemonService.ListDesktops(ctx, req.ClusterUri)
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	response := &api.ListDesktopsResponse{}
+	for _, desktop := range desktops {
+		response.Desktops = append(response.Desktops, newAPIDesktop(desktop))
+	}
+
+	return response, nil
+}
+
+// GetDesktopConnectParams returns the parameters the app uses to open a session to a desktop
+// through the proxy's desktop websocket endpoint
+func (s *Handler) GetDesktopConnectParams(ctx context.Context, req *api.GetDesktopConnectParamsRequest) (*api.GetDesktopConnectParamsResponse, error) {
+	params, err := s.DaemonService.GetDesktopConnectParams(ctx, req.DesktopUri)
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	return &api.GetDesktopConnectParamsResponse{
+		WebProxyAddr: params.WebProxyAddr,
+		Path:         params.Path,
+		Hostname:     params.Hostname,
+	}, nil
+}
+
+func newAPIDesktop(desktop clusters.Desktop) *api.Desktop {
+	apiLabels := APILabels{}
+	for name, value := range desktop.GetAllLabels() {
+		apiLabels = append(apiLabels, &api.Label{
+			Name:  name,
+			Value: value,
+		})
+	}
+
+	sort.Sort(apiLabels)
+
+	return &api.Desktop{
+		Uri:    desktop.URI.String(),
+		Name:   desktop.GetName(),
+		Addr:   desktop.GetAddr(),
+		Labels: apiLabels,
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright 2022 Gravitational, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"context"
+
+	api "github.com/gravitational/teleport/lib/teleterm/api/protogen/golang/v1"
+
+	"github.com/gravitational/trace"
+)
+
+// AddFavorite pins a resource so the UI can offer a stable favorites section
+func (s *Handler) AddFavorite(ctx context.Context, req *api.AddFavoriteRequest) (*api.FavoritesList, error) {
+	favorites, err := s.DaemonService.AddFavorite(req.ResourceUri)
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	return &api.FavoritesList{ResourceUris: favorites}, nil
+}
+
+// RemoveFavorite unpins a resource
+func (s *Handler) RemoveFavorite(ctx context.Context, req *api.RemoveFavoriteRequest) (*api.FavoritesList, error) {
+	favorites, err := s.DaemonService.RemoveFavorite(req.ResourceUri)
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	return &api.FavoritesList{ResourceUris: favorites}, nil
+}
+
+// ListFavorites returns the pinned resources of a cluster, or of all clusters when no cluster
+// URI is given
+func (s *Handler) ListFavorites(ctx context.Context, req *api.ListFavoritesRequest) (*api.FavoritesList, error) {
+	favorites, err := s.DaemonService.ListFavorites(req.ClusterUri)
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	return &api.FavoritesList{ResourceUris: favorites}, nil
+}
@@ -0,0 +1,54 @@
+// Copyright 2022 Gravitational, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	api "github.com/gravitational/teleport/lib/teleterm/api/protogen/golang/v1"
+	"github.com/gravitational/teleport/lib/teleterm/clusters"
+
+	"github.com/gravitational/trace"
+)
+
+// TransferFile copies a single file between the local machine and a cluster server, streaming
+// progress after each written chunk. The transfer is aborted when the client cancels the stream
+func (s *Handler) TransferFile(req *api.FileTransferRequest, stream api.TerminalService_TransferFileServer) error {
+	var download bool
+	switch req.Direction {
+	case api.FileTransferRequest_FILE_TRANSFER_DIRECTION_DOWNLOAD:
+		download = true
+	case api.FileTransferRequest_FILE_TRANSFER_DIRECTION_UPLOAD:
+		download = false
+	default:
+		return trace.BadParameter("unsupported transfer direction %v", req.Direction)
+	}
+
+	err := s.DaemonService.TransferFile(stream.Context(), clusters.FileTransferParams{
+		ServerURI:   req.ServerUri,
+		Login:       req.Login,
+		Source:      req.Source,
+		Destination: req.Destination,
+		Download:    download,
+		OnProgress: func(written, total int64) {
+			// A failure to deliver a progress update cancels the stream context and
+			// thereby aborts the transfer, no need to handle it here.
+			stream.Send(&api.FileTransferProgress{
+				Written: uint64(written),
+				Total:   uint64(total),
+			})
+		},
+	})
+
+	return trace.Wrap(err)
+}
@@ -0,0 +1,52 @@
+// Copyright 2022 Gravitational, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"context"
+	"time"
+
+	api "github.com/gravitational/teleport/lib/teleterm/api/protogen/golang/v1"
+
+	"github.com/gravitational/trace"
+)
+
+// ListRecentConnections returns the recent successful connections, most recent first
+func (s *Handler) ListRecentConnections(ctx context.Context, req *api.ListRecentConnectionsRequest) (*api.ListRecentConnectionsResponse, error) {
+	connections, err := s.DaemonService.ListRecentConnections()
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	response := &api.ListRecentConnectionsResponse{}
+	for _, connection := range connections {
+		response.Connections = append(response.Connections, &api.RecentConnection{
+			ResourceUri: connection.ResourceURI,
+			Params:      connection.Params,
+			ConnectedAt: connection.ConnectedAt.Format(time.RFC3339),
+		})
+	}
+
+	return response, nil
+}
+
+// ClearRecentConnections removes all recent connections
+func (s *Handler) ClearRecentConnections(ctx context.Context, req *api.ClearRecentConnectionsRequest) (*api.EmptyResponse, error) {
+	if err := s.DaemonService.ClearRecentConnections(); err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	return &api.EmptyResponse{}, nil
+}
@@ -0,0 +1,107 @@
+// Copyright 2022 Gravitational, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	api "github.com/gravitational/teleport/lib/teleterm/api/protogen/golang/v1"
+	"github.com/gravitational/teleport/lib/teleterm/clusters"
+
+	"github.com/gravitational/trace"
+)
+
+// GetRecordings returns a page of recorded sessions of a cluster along with the key of the next
+// page
+func (s *Handler) GetRecordings(ctx context.Context, req *api.GetRecordingsRequest) (*api.GetRecordingsResponse, error) {
+	params := clusters.GetRecordingsParams{
+		Limit:    int(req.Limit),
+		StartKey: req.StartKey,
+	}
+
+	if req.From != "" {
+		from, err := time.Parse(time.RFC3339, req.From)
+		if err != nil {
+			return nil, trace.BadParameter("invalid from time: %v", err)
+		}
+		params.FromUTC = from.UTC()
+	}
+	if req.To != "" {
+		to, err := time.Parse(time.RFC3339, req.To)
+		if err != nil {
+			return nil, trace.BadParameter("invalid to time: %v", err)
+		}
+		params.ToUTC = to.UTC()
+	}
+
+	recordings, nextKey, err := s.DaemonService.GetRecordings(ctx, req.ClusterUri, params)
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	response := &api.GetRecordingsResponse{
+		NextKey: nextKey,
+	}
+	for _, recording := range recordings {
+		response.Recordings = append(response.Recordings, newAPIRecording(recording))
+	}
+
+	return response, nil
+}
+
+// StreamRecording streams the playback events of a recorded session. Seeking is done by
+// restarting the stream at a different event index
+func (s *Handler) StreamRecording(req *api.StreamRecordingRequest, stream api.TerminalService_StreamRecordingServer) error {
+	eventsC, errC, err := s.DaemonService.StreamRecording(stream.Context(), req.ClusterUri, req.SessionId, req.StartIndex)
+	if err != nil {
+		return trace.Wrap(err)
+	}
+
+	for {
+		select {
+		case event, ok := <-eventsC:
+			if !ok {
+				// The channel is closed once all the events of the recording were streamed.
+				return nil
+			}
+
+			data, err := json.Marshal(event)
+			if err != nil {
+				return trace.Wrap(err)
+			}
+			if err := stream.Send(&api.RecordingEvent{Data: data}); err != nil {
+				return trace.Wrap(err)
+			}
+		case err := <-errC:
+			return trace.Wrap(err)
+		case <-stream.Context().Done():
+			return nil
+		}
+	}
+}
+
+func newAPIRecording(recording clusters.Recording) *api.Recording {
+	return &api.Recording{
+		SessionId:    recording.SessionID,
+		Hostname:     recording.Hostname,
+		User:         recording.User,
+		Participants: recording.Participants,
+		StartTime:    recording.StartTime.Format(time.RFC3339),
+		EndTime:      recording.EndTime.Format(time.RFC3339),
+		Interactive:  recording.Interactive,
+	}
+}
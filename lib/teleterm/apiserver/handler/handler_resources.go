@@ -0,0 +1,148 @@
+// Copyright 2022 Gravitational, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"context"
+	"sort"
+
+	"github.com/gravitational/teleport/api/types"
+	api "github.com/gravitational/teleport/lib/teleterm/api/protogen/golang/v1"
+	"github.com/gravitational/teleport/lib/teleterm/daemon"
+
+	"github.com/gravitational/trace"
+)
+
+// SearchResources returns resources from all logged-in clusters matching the search phrase,
+// ranked by how well they match
+func (s *Handler) SearchResources(ctx context.Context, req *api.SearchResourcesRequest) (*api.SearchResourcesResponse, error) {
+	results, err := s.DaemonService.SearchResources(ctx, daemon.SearchResourcesParams{
+		Search: req.Search,
+		Limit:  int(req.Limit),
+	})
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	response := &api.SearchResourcesResponse{}
+	for _, result := range results {
+		response.Results = append(response.Results, newAPISearchResult(result))
+	}
+
+	return response, nil
+}
+
+func newAPISearchResult(result daemon.SearchResult) *api.SearchResult {
+	apiLabels := APILabels{}
+	for name, value := range result.Labels {
+		apiLabels = append(apiLabels, &api.Label{
+			Name:  name,
+			Value: value,
+		})
+	}
+
+	sort.Sort(apiLabels)
+
+	return &api.SearchResult{
+		ClusterUri:  result.ClusterURI,
+		ResourceUri: result.ResourceURI,
+		Kind:        result.Kind,
+		Name:        result.Name,
+		Labels:      apiLabels,
+		Score:       int32(result.Score),
+	}
+}
+
+// FetchClusterResources fetches the resources of several clusters at once, one result per
+// requested cluster
+func (s *Handler) FetchClusterResources(ctx context.Context, req *api.FetchClusterResourcesRequest) (*api.FetchClusterResourcesResponse, error) {
+	results := s.DaemonService.FetchClusterResources(ctx, req.ClusterUris)
+
+	response := &api.FetchClusterResourcesResponse{}
+	for _, result := range results {
+		apiResult := &api.ClusterResources{
+			ClusterUri: result.ClusterURI,
+		}
+		for _, server := range result.Servers {
+			apiResult.Servers = append(apiResult.Servers, newAPIServer(server))
+		}
+		for _, db := range result.Databases {
+			apiResult.Databases = append(apiResult.Databases, newAPIDatabase(db))
+		}
+		for _, kube := range result.Kubes {
+			apiResult.Kubes = append(apiResult.Kubes, newAPIKube(kube))
+		}
+		for _, app := range result.Apps {
+			apiResult.Apps = append(apiResult.Apps, newAPIApp(app))
+		}
+		if result.Err != nil {
+			apiResult.Error = result.Err.Error()
+		}
+
+		response.Results = append(response.Results, apiResult)
+	}
+
+	return response, nil
+}
+
+// WatchResources streams changes to the resources of a cluster until the client closes the
+// stream
+func (s *Handler) WatchResources(req *api.WatchResourcesRequest, stream api.TerminalService_WatchResourcesServer) error {
+	watcher, err := s.DaemonService.WatchResources(stream.Context(), req.ClusterUri)
+	if err != nil {
+		return trace.Wrap(err)
+	}
+	defer watcher.Close()
+
+	for {
+		select {
+		case event := <-watcher.Events():
+			apiEvent, ok := newAPIResourceEvent(event)
+			if !ok {
+				continue
+			}
+			if err := stream.Send(apiEvent); err != nil {
+				return trace.Wrap(err)
+			}
+		case <-watcher.Done():
+			return trace.Wrap(watcher.Error())
+		case <-stream.Context().Done():
+			return nil
+		}
+	}
+}
+
+func newAPIResourceEvent(event types.Event) (*api.ResourceEvent, bool) {
+	switch event.Type {
+	case types.OpInit:
+		return &api.ResourceEvent{
+			Operation: api.ResourceEvent_OPERATION_INIT,
+		}, true
+	case types.OpPut:
+		return &api.ResourceEvent{
+			Operation: api.ResourceEvent_OPERATION_PUT,
+			Kind:      event.Resource.GetKind(),
+			Name:      event.Resource.GetName(),
+		}, true
+	case types.OpDelete:
+		return &api.ResourceEvent{
+			Operation: api.ResourceEvent_OPERATION_DELETE,
+			Kind:      event.Resource.GetKind(),
+			Name:      event.Resource.GetName(),
+		}, true
+	default:
+		return nil, false
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright 2022 Gravitational, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"io"
+	"sync"
+
+	api "github.com/gravitational/teleport/lib/teleterm/api/protogen/golang/v1"
+	"github.com/gravitational/teleport/lib/teleterm/clusters"
+
+	"github.com/gravitational/trace"
+)
+
+// ConnectToServer opens an interactive shell on a server. The first request must carry start,
+// subsequent requests carry stdin data or resizes. Responses stream the PTY output followed by
+// the exit status once the shell terminates
+func (s *Handler) ConnectToServer(stream api.TerminalService_ConnectToServerServer) error {
+	req, err := stream.Recv()
+	if err != nil {
+		return trace.Wrap(err)
+	}
+
+	start := req.GetStart()
+	if start == nil || start.GetServerUri() == "" {
+		return trace.BadParameter("expected a start request with the server URI")
+	}
+
+	ctx := stream.Context()
+	sender := &sshResponseSender{stream: stream}
+	stdinReader, stdinWriter := io.Pipe()
+	defer stdinWriter.Close()
+
+	session, err := s.DaemonService.ConnectToServer(ctx, clusters.SSHSessionParams{
+		ServerURI: start.ServerUri,
+		Login:     start.Login,
+		Term:      start.Term,
+		Width:     int(start.Width),
+		Height:    int(start.Height),
+		Stdin:     stdinReader,
+		Stdout: writerFunc(func(p []byte) (int, error) {
+			return sender.sendOutput(p, false)
+		}),
+		Stderr: writerFunc(func(p []byte) (int, error) {
+			return sender.sendOutput(p, true)
+		}),
+	})
+	if err != nil {
+		return trace.Wrap(err)
+	}
+	defer session.Close()
+
+	// Pump the subsequent client requests into the shell. The pump ends once the client closes
+	// its side of the stream or the shell terminates, closing the whole stream.
+	go func() {
+		for {
+			req, err := stream.Recv()
+			if err != nil {
+				stdinWriter.CloseWithError(err)
+				return
+			}
+
+			switch payload := req.Request.(type) {
+			case *api.ConnectToServerRequest_Stdin:
+				if _, err := stdinWriter.Write(payload.Stdin); err != nil {
+					return
+				}
+			case *api.ConnectToServerRequest_Resize:
+				if err := session.Resize(ctx, int(payload.Resize.Width), int(payload.Resize.Height)); err != nil {
+					return
+				}
+			}
+		}
+	}()
+
+	code, err := session.Wait()
+	if err != nil {
+		return trace.Wrap(err)
+	}
+
+	return trace.Wrap(sender.send(&api.ConnectToServerResponse{
+		Response: &api.ConnectToServerResponse_Exit{
+			Exit: &api.SSHSessionExit{
+				Code: int32(code),
+			},
+		},
+	}))
+}
+
+// sshResponseSender serializes the stream responses: stdout, stderr and the exit status are
+// written from different goroutines while grpc allows only one concurrent Send per stream.
+type sshResponseSender struct {
+	mu     sync.Mutex
+	stream api.TerminalService_ConnectToServerServer
+}
+
+func (s *sshResponseSender) send(response *api.ConnectToServerResponse) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return trace.Wrap(s.stream.Send(response))
+}
+
+func (s *sshResponseSender) sendOutput(p []byte, stderr bool) (int, error) {
+	// The stream retains the message until it is serialized, so the buffer cannot be reused
+	// by the caller before it is copied.
+	data := make([]byte, len(p))
+	copy(data, p)
+
+	response := &api.ConnectToServerResponse{}
+	if stderr {
+		response.Response = &api.ConnectToServerResponse_Stderr{Stderr: data}
+	} else {
+		response.Response = &api.ConnectToServerResponse_Stdout{Stdout: data}
+	}
+
+	if err := s.send(response); err != nil {
+		return 0, trace.Wrap(err)
+	}
+
+	return len(p), nil
+}
+
+// writerFunc is a function wrapper that implements the io.Writer interface.
+type writerFunc func(p []byte) (int, error)
+
+func (f writerFunc) Write(p []byte) (int, error) {
+	return f(p)
+}
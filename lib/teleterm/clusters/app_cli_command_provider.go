@@ -0,0 +1,40 @@
+// Copyright 2022 Gravitational, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clusters
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/gravitational/teleport/lib/teleterm/gateway"
+)
+
+// AppCLICommandProvider provides CLI commands for app gateways. Web apps can be curled through
+// the gateway. TCP apps have no general-purpose CLI client, the user connects their own client to
+// the local address instead, so no command is returned for them.
+type AppCLICommandProvider struct{}
+
+func NewAppCLICommandProvider() AppCLICommandProvider {
+	return AppCLICommandProvider{}
+}
+
+func (a AppCLICommandProvider) GetCommand(gw *gateway.Gateway) (string, error) {
+	if gw.Protocol() != gateway.ProtocolAppHTTP {
+		return "", nil
+	}
+
+	address := net.JoinHostPort(gw.LocalAddress(), gw.LocalPort())
+	return fmt.Sprintf("curl http://%s", address), nil
+}
@@ -18,6 +18,7 @@ package clusters
 
 import (
 	"context"
+	"time"
 
 	"github.com/gravitational/teleport/api/types"
 	"github.com/gravitational/teleport/lib/client"
@@ -97,6 +98,17 @@ func (c *Cluster) GetProxyHost() string {
 	return c.status.ProxyURL.Host
 }
 
+// GetCertExpiry returns the time at which the user certs expire.
+func (c *Cluster) GetCertExpiry() time.Time {
+	return c.status.ValidUntil
+}
+
+// HasAssumedRoles is true when the user certs carry roles assumed from access requests, in which
+// case the cert expiry is also when the assumed roles are dropped.
+func (c *Cluster) HasAssumedRoles() bool {
+	return len(c.status.ActiveRequests.AccessRequests) > 0
+}
+
 // LoggedInUser is the currently logged-in user
 type LoggedInUser struct {
 	// Name is the user name
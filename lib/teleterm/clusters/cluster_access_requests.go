@@ -0,0 +1,182 @@
+// Copyright 2022 Gravitational, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clusters
+
+import (
+	"context"
+
+	"github.com/gravitational/teleport/api/types"
+	apiutils "github.com/gravitational/teleport/api/utils"
+	"github.com/gravitational/teleport/lib/auth"
+	"github.com/gravitational/teleport/lib/client"
+	"github.com/gravitational/teleport/lib/services"
+
+	"github.com/gravitational/trace"
+)
+
+// CreateAccessRequestParams describes a new access request.
+type CreateAccessRequestParams struct {
+	// Reason is the request reason shown to the reviewers.
+	Reason string
+	// Roles are the requested roles.
+	Roles []string
+	// SuggestedReviewers are the users suggested to review the request.
+	SuggestedReviewers []string
+	// ResourceIDs are the requested resources, may be empty for role-only requests.
+	ResourceIDs []types.ResourceID
+}
+
+// ReviewAccessRequestParams describes a review of an access request.
+type ReviewAccessRequestParams struct {
+	// RequestID is the ID of the reviewed access request.
+	RequestID string
+	// State is the proposed state, types.RequestState_APPROVED or types.RequestState_DENIED.
+	State types.RequestState
+	// Reason is the review reason shown to the requester.
+	Reason string
+}
+
+// GetAccessRequests returns the access requests matching the filter. Requests always live in the
+// root cluster, so for leaf clusters the root ones are returned.
+func (c *Cluster) GetAccessRequests(ctx context.Context, filter types.AccessRequestFilter) ([]types.AccessRequest, error) {
+	var requests []types.AccessRequest
+	err := addMetadataToRetryableError(ctx, func() error {
+		return c.clusterClient.WithRootClusterClient(ctx, func(authClient auth.ClientI) error {
+			var err error
+			requests, err = authClient.GetAccessRequests(ctx, filter)
+			return trace.Wrap(err)
+		})
+	})
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	return requests, nil
+}
+
+// CreateAccessRequest creates an access request for the logged-in user.
+func (c *Cluster) CreateAccessRequest(ctx context.Context, params CreateAccessRequestParams) (types.AccessRequest, error) {
+	request, err := services.NewAccessRequestWithResources(c.status.Username, params.Roles, params.ResourceIDs)
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	request.SetRequestReason(params.Reason)
+	request.SetSuggestedReviewers(params.SuggestedReviewers)
+
+	err = addMetadataToRetryableError(ctx, func() error {
+		return c.clusterClient.WithRootClusterClient(ctx, func(authClient auth.ClientI) error {
+			return trace.Wrap(authClient.CreateAccessRequest(ctx, request))
+		})
+	})
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	return request, nil
+}
+
+// ReviewAccessRequest submits a review of an access request and returns the post-review state of
+// the request.
+func (c *Cluster) ReviewAccessRequest(ctx context.Context, params ReviewAccessRequestParams) (types.AccessRequest, error) {
+	var request types.AccessRequest
+	err := addMetadataToRetryableError(ctx, func() error {
+		return c.clusterClient.WithRootClusterClient(ctx, func(authClient auth.ClientI) error {
+			var err error
+			request, err = authClient.SubmitAccessReview(ctx, types.AccessReviewSubmission{
+				RequestID: params.RequestID,
+				Review: types.AccessReview{
+					Author:        c.status.Username,
+					ProposedState: params.State,
+					Reason:        params.Reason,
+				},
+			})
+			return trace.Wrap(err)
+		})
+	})
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	return request, nil
+}
+
+// DeleteAccessRequest removes an access request.
+func (c *Cluster) DeleteAccessRequest(ctx context.Context, requestID string) error {
+	err := addMetadataToRetryableError(ctx, func() error {
+		return c.clusterClient.WithRootClusterClient(ctx, func(authClient auth.ClientI) error {
+			return trace.Wrap(authClient.DeleteAccessRequest(ctx, requestID))
+		})
+	})
+	return trace.Wrap(err)
+}
+
+// GetAccessCapabilities returns which roles the logged-in user can request and which reviewers
+// can be suggested, powering the pickers of the request form.
+func (c *Cluster) GetAccessCapabilities(ctx context.Context) (*types.AccessCapabilities, error) {
+	var caps *types.AccessCapabilities
+	err := addMetadataToRetryableError(ctx, func() error {
+		return c.clusterClient.WithRootClusterClient(ctx, func(authClient auth.ClientI) error {
+			var err error
+			caps, err = authClient.GetAccessCapabilities(ctx, types.AccessCapabilitiesRequest{
+				RequestableRoles:   true,
+				SuggestedReviewers: true,
+			})
+			return trace.Wrap(err)
+		})
+	})
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	return caps, nil
+}
+
+// AssumeRoles reissues the user certs with the given approved access requests added and the given
+// requests dropped, then refreshes the profile so the new access takes effect in subsequent
+// connections.
+func (c *Cluster) AssumeRoles(ctx context.Context, requestIDs, dropRequestIDs []string) error {
+	err := addMetadataToRetryableError(ctx, func() error {
+		params := client.ReissueParams{
+			AccessRequests:     requestIDs,
+			DropAccessRequests: dropRequestIDs,
+			RouteToCluster:     c.clusterClient.SiteName,
+		}
+
+		// Keep the active requests the certs already carry unless they are explicitly dropped.
+		for _, reqID := range c.status.ActiveRequests.AccessRequests {
+			if !apiutils.SliceContainsStr(dropRequestIDs, reqID) {
+				params.AccessRequests = append(params.AccessRequests, reqID)
+			}
+		}
+
+		return trace.Wrap(c.clusterClient.ReissueUserCerts(ctx, client.CertCacheDrop, params))
+	})
+	if err != nil {
+		return trace.Wrap(err)
+	}
+
+	if err := c.clusterClient.SaveProfile(c.dir, true); err != nil {
+		return trace.Wrap(err)
+	}
+
+	status, err := client.ReadProfileStatus(c.dir, c.status.Name)
+	if err != nil {
+		return trace.Wrap(err)
+	}
+	c.status = *status
+
+	return nil
+}
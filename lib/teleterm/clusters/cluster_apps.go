@@ -22,6 +22,7 @@ import (
 	"github.com/gravitational/teleport/api/client/proto"
 	"github.com/gravitational/teleport/api/defaults"
 	"github.com/gravitational/teleport/api/types"
+	"github.com/gravitational/teleport/lib/client"
 	"github.com/gravitational/teleport/lib/teleterm/api/uri"
 
 	"github.com/gravitational/trace"
@@ -35,6 +36,22 @@ type App struct {
 	types.Application
 }
 
+// GetApp returns an app
+func (c *Cluster) GetApp(ctx context.Context, appURI string) (*App, error) {
+	apps, err := c.GetApps(ctx)
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	for _, app := range apps {
+		if app.URI.String() == appURI {
+			return &app, nil
+		}
+	}
+
+	return nil, trace.NotFound("app is not found: %v", appURI)
+}
+
 // GetApps returns apps
 func (c *Cluster) GetApps(ctx context.Context) ([]App, error) {
 	var apps []types.Application
@@ -59,3 +76,39 @@ func (c *Cluster) GetApps(ctx context.Context) ([]App, error) {
 
 	return results, nil
 }
+
+// ReissueAppCerts issues new certificates for specific app access
+func (c *Cluster) ReissueAppCerts(ctx context.Context, app types.Application) error {
+	err := addMetadataToRetryableError(ctx, func() error {
+		// An app session must exist before the certs for the app can be requested.
+		ws, err := c.clusterClient.CreateAppSession(ctx, types.CreateAppSessionRequest{
+			Username:    c.status.Username,
+			PublicAddr:  app.GetPublicAddr(),
+			ClusterName: c.clusterClient.SiteName,
+		})
+		if err != nil {
+			return trace.Wrap(err)
+		}
+
+		err = c.clusterClient.ReissueUserCerts(ctx, client.CertCacheKeep, client.ReissueParams{
+			RouteToCluster: c.clusterClient.SiteName,
+			RouteToApp: proto.RouteToApp{
+				Name:        app.GetName(),
+				SessionID:   ws.GetName(),
+				PublicAddr:  app.GetPublicAddr(),
+				ClusterName: c.clusterClient.SiteName,
+			},
+			AccessRequests: c.status.ActiveRequests.AccessRequests,
+		})
+		if err != nil {
+			return trace.Wrap(err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return trace.Wrap(err)
+	}
+
+	return nil
+}
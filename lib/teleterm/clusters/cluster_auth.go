@@ -21,6 +21,7 @@ import (
 	"errors"
 	"fmt"
 	"sort"
+	"time"
 
 	"github.com/gravitational/teleport/api/client/webclient"
 	"github.com/gravitational/teleport/api/constants"
@@ -28,6 +29,7 @@ import (
 	wancli "github.com/gravitational/teleport/lib/auth/webauthncli"
 	"github.com/gravitational/teleport/lib/client"
 	dbprofile "github.com/gravitational/teleport/lib/client/db"
+	"github.com/gravitational/teleport/lib/defaults"
 	"github.com/gravitational/teleport/lib/kube/kubeconfig"
 	api "github.com/gravitational/teleport/lib/teleterm/api/protogen/golang/v1"
 
@@ -133,8 +135,13 @@ func (c *Cluster) LocalLogin(ctx context.Context, user, password, otpToken strin
 	return nil
 }
 
-// SSOLogin logs in a user to the Teleport cluster using supported SSO provider
-func (c *Cluster) SSOLogin(ctx context.Context, providerType, providerName string) error {
+// SSOLogin logs in a user to the Teleport cluster using supported SSO provider.
+//
+// The daemon drives the flow end-to-end: it starts the local callback listener and completes the
+// cert exchange once the IdP redirects the browser back. If onRedirectURL is set, it is called
+// with the IdP URL as soon as the listener is up so the UI can open it; otherwise the daemon
+// attempts to launch the system browser itself.
+func (c *Cluster) SSOLogin(ctx context.Context, providerType, providerName string, onRedirectURL func(url string)) error {
 	if _, err := c.clusterClient.Ping(ctx); err != nil {
 		return trace.Wrap(err)
 	}
@@ -148,7 +155,7 @@ func (c *Cluster) SSOLogin(ctx context.Context, providerType, providerName strin
 	// existing profile for the first time (investigate why)
 	c.clusterClient.SiteName = ""
 
-	response, err := client.SSHAgentSSOLogin(ctx, client.SSHLoginSSO{
+	login := client.SSHLoginSSO{
 		SSHLogin: client.SSHLogin{
 			ProxyAddr:         c.clusterClient.WebProxyAddr,
 			PubKey:            key.MarshalSSHPublicKey(),
@@ -161,7 +168,14 @@ func (c *Cluster) SSOLogin(ctx context.Context, providerType, providerName strin
 		Protocol:    providerType,
 		BindAddr:    c.clusterClient.BindAddr,
 		Browser:     c.clusterClient.Browser,
-	}, nil)
+	}
+
+	var response *auth.SSHLoginResponse
+	if onRedirectURL != nil {
+		response, err = c.ssoLoginWithRedirectURL(ctx, login, onRedirectURL)
+	} else {
+		response, err = client.SSHAgentSSOLogin(ctx, login, nil)
+	}
 	if err != nil {
 		return trace.Wrap(err)
 	}
@@ -173,6 +187,33 @@ func (c *Cluster) SSOLogin(ctx context.Context, providerType, providerName strin
 	return nil
 }
 
+// ssoLoginWithRedirectURL runs the SSO redirector without opening a browser, handing the IdP URL
+// to the caller instead, and waits for the callback with the issued certs.
+func (c *Cluster) ssoLoginWithRedirectURL(ctx context.Context, login client.SSHLoginSSO, onRedirectURL func(url string)) (*auth.SSHLoginResponse, error) {
+	rd, err := client.NewRedirector(ctx, login, nil)
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	if err := rd.Start(); err != nil {
+		return nil, trace.Wrap(err)
+	}
+	defer rd.Close()
+
+	onRedirectURL(rd.ClickableURL())
+
+	select {
+	case err := <-rd.ErrorC():
+		return nil, trace.Wrap(err)
+	case response := <-rd.ResponseC():
+		return response, nil
+	case <-time.After(defaults.CallbackTimeout):
+		return nil, trace.LimitExceeded("timed out waiting for the SSO callback")
+	case <-rd.Done():
+		return nil, trace.Wrap(ctx.Err(), "login canceled")
+	}
+}
+
 func (c *Cluster) localMFALogin(ctx context.Context, user, password string) error {
 	key, err := client.GenerateRSAKey()
 	if err != nil {
@@ -0,0 +1,165 @@
+// Copyright 2022 Gravitational, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clusters
+
+import (
+	"context"
+	"fmt"
+	"os/user"
+	"time"
+
+	"github.com/gravitational/teleport/api/types"
+	apiutils "github.com/gravitational/teleport/api/utils"
+	"github.com/gravitational/teleport/lib/auth"
+	"github.com/gravitational/teleport/lib/utils"
+
+	"github.com/gravitational/trace"
+)
+
+// ConnectMyComputerNodeOwnerLabel is the label identifying the user whose computer runs the node
+// agent started through the Connect My Computer flow.
+const ConnectMyComputerNodeOwnerLabel = "teleport.dev/connect-my-computer/owner"
+
+// connectMyComputerTokenTTL is deliberately short: the token is created right before the agent
+// joins and is not meant to be reused.
+const connectMyComputerTokenTTL = 5 * time.Minute
+
+// connectMyComputerRoleName returns the name of the role granting the logged-in user access to
+// their own Connect My Computer node.
+func (c *Cluster) connectMyComputerRoleName() string {
+	return fmt.Sprintf("connect-my-computer-%v", c.status.Username)
+}
+
+// CreateConnectMyComputerRole creates a role that lets the logged-in user connect to nodes
+// labeled as owned by them and assigns the role to the user. The new role takes effect only
+// after the user certs are reissued, e.g. through a relogin.
+func (c *Cluster) CreateConnectMyComputerRole(ctx context.Context) (types.Role, error) {
+	systemUser, err := user.Current()
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	role, err := types.NewRole(c.connectMyComputerRoleName(), types.RoleSpecV5{
+		Allow: types.RoleConditions{
+			Logins: []string{systemUser.Username},
+			NodeLabels: types.Labels{
+				ConnectMyComputerNodeOwnerLabel: []string{c.status.Username},
+			},
+		},
+	})
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	err = addMetadataToRetryableError(ctx, func() error {
+		return c.clusterClient.WithRootClusterClient(ctx, func(authClient auth.ClientI) error {
+			if err := authClient.UpsertRole(ctx, role); err != nil {
+				return trace.Wrap(err)
+			}
+
+			clusterUser, err := authClient.GetUser(c.status.Username, false)
+			if err != nil {
+				return trace.Wrap(err)
+			}
+
+			if apiutils.SliceContainsStr(clusterUser.GetRoles(), role.GetName()) {
+				return nil
+			}
+
+			clusterUser.AddRole(role.GetName())
+			return trace.Wrap(authClient.UpsertUser(clusterUser))
+		})
+	})
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	return role, nil
+}
+
+// CreateConnectMyComputerNodeToken creates a short-lived provision token the node agent uses to
+// join the cluster.
+func (c *Cluster) CreateConnectMyComputerNodeToken(ctx context.Context) (string, error) {
+	tokenName, err := utils.CryptoRandomHex(auth.TokenLenBytes)
+	if err != nil {
+		return "", trace.Wrap(err)
+	}
+
+	token, err := types.NewProvisionTokenFromSpec(tokenName, time.Now().Add(connectMyComputerTokenTTL), types.ProvisionTokenSpecV2{
+		Roles: []types.SystemRole{types.RoleNode},
+	})
+	if err != nil {
+		return "", trace.Wrap(err)
+	}
+
+	err = addMetadataToRetryableError(ctx, func() error {
+		return c.clusterClient.WithRootClusterClient(ctx, func(authClient auth.ClientI) error {
+			return trace.Wrap(authClient.UpsertToken(ctx, token))
+		})
+	})
+	if err != nil {
+		return "", trace.Wrap(err)
+	}
+
+	return tokenName, nil
+}
+
+// WaitForConnectMyComputerNodeJoin blocks until a node owned by the logged-in user shows up in
+// the cluster, returning that node. The caller controls the timeout through ctx.
+func (c *Cluster) WaitForConnectMyComputerNodeJoin(ctx context.Context) (*Server, error) {
+	// Start the watcher before listing so a node joining in between is not missed.
+	watcher, err := c.WatchResources(ctx)
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+	defer watcher.Close()
+
+	servers, err := c.GetServers(ctx)
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+	for _, server := range servers {
+		if c.isConnectMyComputerNode(server.Server) {
+			return &server, nil
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, trace.Wrap(ctx.Err())
+		case <-watcher.Done():
+			return nil, trace.Wrap(watcher.Error())
+		case event := <-watcher.Events():
+			if event.Type != types.OpPut {
+				continue
+			}
+			server, ok := event.Resource.(types.Server)
+			if !ok {
+				continue
+			}
+			if c.isConnectMyComputerNode(server) {
+				return &Server{
+					URI:    c.URI.AppendServer(server.GetName()),
+					Server: server,
+				}, nil
+			}
+		}
+	}
+}
+
+func (c *Cluster) isConnectMyComputerNode(server types.Server) bool {
+	return server.GetAllLabels()[ConnectMyComputerNodeOwnerLabel] == c.status.Username
+}
@@ -18,6 +18,8 @@ package clusters
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/gravitational/teleport/api/client/proto"
 	"github.com/gravitational/teleport/api/defaults"
@@ -92,8 +94,121 @@ func (c *Cluster) GetDatabases(ctx context.Context) ([]Database, error) {
 	return responseDbs, nil
 }
 
-// ReissueDBCerts issues new certificates for specific DB access
-func (c *Cluster) ReissueDBCerts(ctx context.Context, user string, db types.Database) error {
+// GetDatabasesParams describe server-side filtering, sorting and pagination of a database
+// listing.
+type GetDatabasesParams struct {
+	// Search is a space-separated list of search keywords matched server-side.
+	Search string
+	// Labels are the labels the databases must have.
+	Labels map[string]string
+	// Protocols limits the listing to databases speaking one of the given protocols.
+	Protocols []string
+	// SortField is the field to sort by, e.g. "name". Empty means no sorting.
+	SortField string
+	// SortDesc reverses the sort order.
+	SortDesc bool
+	// Limit caps the page size, defaults to defaults.DefaultChunkSize.
+	Limit int
+	// StartKey is the key of the page to fetch, empty for the first page.
+	StartKey string
+}
+
+// GetDatabasesResponse is a single page of a filtered database listing.
+type GetDatabasesResponse struct {
+	// Databases is the page of databases.
+	Databases []Database
+	// StartKey is the key of the next page, empty when this is the last page.
+	StartKey string
+	// TotalCount is the total number of databases matching the filters.
+	TotalCount int
+}
+
+// GetDatabasesWithFilters returns a single page of databases matching the filters. Unlike
+// GetDatabases, the search, label and protocol filters as well as sorting and pagination are
+// pushed down to the auth server, so large clusters don't require fetching everything client-side.
+func (c *Cluster) GetDatabasesWithFilters(ctx context.Context, params GetDatabasesParams) (*GetDatabasesResponse, error) {
+	limit := params.Limit
+	if limit <= 0 {
+		limit = defaults.DefaultChunkSize
+	}
+
+	var predicates []string
+	for _, protocol := range params.Protocols {
+		predicates = append(predicates, fmt.Sprintf("resource.spec.protocol == %q", protocol))
+	}
+
+	req := proto.ListResourcesRequest{
+		Namespace:           defaults.Namespace,
+		ResourceType:        types.KindDatabaseServer,
+		Limit:               int32(limit),
+		StartKey:            params.StartKey,
+		SearchKeywords:      client.ParseSearchKeywords(params.Search, ' '),
+		Labels:              params.Labels,
+		PredicateExpression: strings.Join(predicates, " || "),
+		SortBy: types.SortBy{
+			Field:  params.SortField,
+			IsDesc: params.SortDesc,
+		},
+		NeedTotalCount: true,
+	}
+
+	var resp *types.ListResourcesResponse
+	err := addMetadataToRetryableError(ctx, func() error {
+		proxyClient, err := c.clusterClient.ConnectToProxy(ctx)
+		if err != nil {
+			return trace.Wrap(err)
+		}
+		defer proxyClient.Close()
+
+		authClient, err := proxyClient.ConnectToCluster(ctx, c.clusterClient.SiteName)
+		if err != nil {
+			return trace.Wrap(err)
+		}
+		defer authClient.Close()
+
+		resp, err = authClient.ListResources(ctx, req)
+		if err != nil {
+			return trace.Wrap(err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	var dbServers []types.DatabaseServer
+	for _, resource := range resp.Resources {
+		dbServer, ok := resource.(types.DatabaseServer)
+		if !ok {
+			return nil, trace.BadParameter("expected types.DatabaseServer, got %T", resource)
+		}
+		dbServers = append(dbServers, dbServer)
+	}
+
+	response := &GetDatabasesResponse{
+		StartKey:   resp.NextKey,
+		TotalCount: resp.TotalCount,
+	}
+	for _, db := range types.DeduplicateDatabases(types.DatabaseServers(dbServers).ToDatabases()) {
+		response.Databases = append(response.Databases, Database{
+			URI:      c.URI.AppendDB(db.GetName()),
+			Database: db,
+		})
+	}
+
+	return response, nil
+}
+
+// ReissueDBCerts issues new certificates for specific DB access. When the cluster requires
+// per-session MFA for the database, promptMFA is called to present the challenge to the user and
+// the issuance blocks until the user responds.
+func (c *Cluster) ReissueDBCerts(ctx context.Context, user string, db types.Database, promptMFA client.PromptMFAChallengeHandler) error {
+	if promptMFA == nil {
+		promptMFA = func(ctx context.Context, proxyAddr string, c *proto.MFAAuthenticateChallenge) (*proto.MFAAuthenticateResponse, error) {
+			return nil, trace.BadParameter("per-session MFA is required to connect to the database, but no MFA prompt is configured")
+		}
+	}
 	// When generating certificate for MongoDB access, database username must
 	// be encoded into it. This is required to be able to tell which database
 	// user to authenticate the connection as.
@@ -111,8 +226,15 @@ func (c *Cluster) ReissueDBCerts(ctx context.Context, user string, db types.Data
 			return trace.Wrap(err)
 		}
 
-		// Fetch the certs for the database.
-		err = c.clusterClient.ReissueUserCerts(ctx, client.CertCacheKeep, client.ReissueParams{
+		// Fetch the certs for the database, going through the per-session MFA check if the
+		// cluster requires one. When MFA is not required this falls back to a plain reissue.
+		proxyClient, err := c.clusterClient.ConnectToProxy(ctx)
+		if err != nil {
+			return trace.Wrap(err)
+		}
+		defer proxyClient.Close()
+
+		key, err := proxyClient.IssueUserCertsWithMFA(ctx, client.ReissueParams{
 			RouteToCluster: c.clusterClient.SiteName,
 			RouteToDatabase: proto.RouteToDatabase{
 				ServiceName: db.GetName(),
@@ -120,12 +242,12 @@ func (c *Cluster) ReissueDBCerts(ctx context.Context, user string, db types.Data
 				Username:    user,
 			},
 			AccessRequests: c.status.ActiveRequests.AccessRequests,
-		})
+		}, promptMFA)
 		if err != nil {
 			return trace.Wrap(err)
 		}
 
-		return nil
+		return trace.Wrap(c.clusterClient.LocalAgent().AddDatabaseKey(key))
 	})
 	if err != nil {
 		return trace.Wrap(err)
@@ -144,8 +266,18 @@ func (c *Cluster) ReissueDBCerts(ctx context.Context, user string, db types.Data
 	return nil
 }
 
+// DatabaseUsers describes the usernames a user can connect to a database with, so the connect
+// dialog can explain where the suggestions come from.
+type DatabaseUsers struct {
+	// Users are the usernames explicitly allowed by the role set.
+	Users []string
+	// WildcardAllowed is true when the role set allows connecting with any username. The
+	// usernames in Users are then merely suggestions derived from the non-wildcard roles.
+	WildcardAllowed bool
+}
+
 // GetAllowedDatabaseUsers returns allowed users for the given database based on the role set.
-func (c *Cluster) GetAllowedDatabaseUsers(ctx context.Context, dbURI string) ([]string, error) {
+func (c *Cluster) GetAllowedDatabaseUsers(ctx context.Context, dbURI string) (*DatabaseUsers, error) {
 	var authClient auth.ClientI
 	var proxyClient *client.ProxyClient
 	var err error
@@ -181,5 +313,48 @@ func (c *Cluster) GetAllowedDatabaseUsers(ctx context.Context, dbURI string) ([]
 
 	dbUsers := roleSet.EnumerateDatabaseUsers(db)
 
-	return dbUsers.Allowed(), nil
+	return &DatabaseUsers{
+		Users:           dbUsers.Allowed(),
+		WildcardAllowed: dbUsers.WildcardAllowed(),
+	}, nil
+}
+
+// GetAllowedDatabaseNames returns allowed names for the given database based on the role set.
+func (c *Cluster) GetAllowedDatabaseNames(ctx context.Context, dbURI string) ([]string, error) {
+	var authClient auth.ClientI
+	var proxyClient *client.ProxyClient
+	var err error
+
+	err = addMetadataToRetryableError(ctx, func() error {
+		proxyClient, err = c.clusterClient.ConnectToProxy(ctx)
+		if err != nil {
+			return trace.Wrap(err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+	defer proxyClient.Close()
+
+	authClient, err = proxyClient.ConnectToCluster(ctx, c.clusterClient.SiteName)
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+	defer authClient.Close()
+
+	roleSet, err := services.FetchAllClusterRoles(ctx, authClient, c.status.Roles, c.status.Traits)
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	db, err := c.GetDatabase(ctx, dbURI)
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	dbNames := roleSet.EnumerateDatabaseNames(db)
+
+	return dbNames.Allowed(), nil
 }
@@ -0,0 +1,110 @@
+// Copyright 2022 Gravitational, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clusters
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gravitational/teleport/api/types"
+	"github.com/gravitational/teleport/lib/teleterm/api/uri"
+
+	"github.com/gravitational/trace"
+)
+
+// Desktop describes a windows desktop
+type Desktop struct {
+	// URI is the desktop URI
+	URI uri.ResourceURI
+
+	types.WindowsDesktop
+}
+
+// DesktopConnectParams carry everything the app needs to open a desktop session through the
+// proxy's desktop websocket endpoint.
+type DesktopConnectParams struct {
+	// WebProxyAddr is the address of the web proxy serving the desktop websocket endpoint.
+	WebProxyAddr string
+	// Path is the path of the websocket endpoint for the desktop.
+	Path string
+	// Hostname is the name of the desktop.
+	Hostname string
+}
+
+// GetDesktops returns cluster desktops
+func (c *Cluster) GetDesktops(ctx context.Context) ([]Desktop, error) {
+	var desktops []types.WindowsDesktop
+	err := addMetadataToRetryableError(ctx, func() error {
+		proxyClient, err := c.clusterClient.ConnectToProxy(ctx)
+		if err != nil {
+			return trace.Wrap(err)
+		}
+		defer proxyClient.Close()
+
+		authClient, err := proxyClient.ConnectToCluster(ctx, c.clusterClient.SiteName)
+		if err != nil {
+			return trace.Wrap(err)
+		}
+		defer authClient.Close()
+
+		desktops, err = authClient.GetWindowsDesktops(ctx, types.WindowsDesktopFilter{})
+		return trace.Wrap(err)
+	})
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	results := []Desktop{}
+	for _, desktop := range desktops {
+		results = append(results, Desktop{
+			URI:            c.URI.AppendDesktop(desktop.GetName()),
+			WindowsDesktop: desktop,
+		})
+	}
+
+	return results, nil
+}
+
+// GetDesktop returns a cluster desktop
+func (c *Cluster) GetDesktop(ctx context.Context, desktopURI string) (*Desktop, error) {
+	desktops, err := c.GetDesktops(ctx)
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	for _, desktop := range desktops {
+		if desktop.URI.String() == desktopURI {
+			return &desktop, nil
+		}
+	}
+
+	return nil, trace.NotFound("desktop is not found: %v", desktopURI)
+}
+
+// GetDesktopConnectParams returns the parameters the app uses to open a session to the desktop
+// through the proxy's desktop websocket endpoint. The websocket itself is authenticated with the
+// app's session, the daemon only resolves the endpoint.
+func (c *Cluster) GetDesktopConnectParams(ctx context.Context, desktopURI string) (*DesktopConnectParams, error) {
+	desktop, err := c.GetDesktop(ctx, desktopURI)
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	return &DesktopConnectParams{
+		WebProxyAddr: c.clusterClient.WebProxyAddr,
+		Path:         fmt.Sprintf("/webapi/sites/%v/desktops/%v/connect", c.clusterClient.SiteName, desktop.GetName()),
+		Hostname:     desktop.GetName(),
+	}, nil
+}
@@ -0,0 +1,172 @@
+/*
+Copyright 2022 Gravitational, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusters
+
+import (
+	"context"
+
+	"github.com/gravitational/teleport/api/client/proto"
+	"github.com/gravitational/teleport/api/client/webclient"
+	"github.com/gravitational/teleport/api/defaults"
+	"github.com/gravitational/teleport/api/types"
+	"github.com/gravitational/teleport/lib/services"
+
+	"github.com/gravitational/trace"
+)
+
+// ResourceAccess describes access verbs of the user for a single resource kind.
+type ResourceAccess struct {
+	List   bool
+	Read   bool
+	Edit   bool
+	Create bool
+	Delete bool
+}
+
+// ACL is the access control list of the logged-in user, computed from the role set. It mirrors
+// the ACL message of the cluster proto.
+type ACL struct {
+	// Sessions defines access to recorded sessions.
+	Sessions ResourceAccess
+	// AuthConnectors defines access to auth connectors.
+	AuthConnectors ResourceAccess
+	// Roles defines access to roles.
+	Roles ResourceAccess
+	// Users defines access to users.
+	Users ResourceAccess
+	// TrustedClusters defines access to trusted clusters.
+	TrustedClusters ResourceAccess
+	// Events defines access to audit logs.
+	Events ResourceAccess
+	// Tokens defines access to tokens.
+	Tokens ResourceAccess
+	// Servers defines access to servers.
+	Servers ResourceAccess
+	// Apps defines access to application servers.
+	Apps ResourceAccess
+	// DBs defines access to database servers.
+	DBs ResourceAccess
+	// Kubeservers defines access to kubernetes servers.
+	Kubeservers ResourceAccess
+	// AccessRequests defines access to access requests.
+	AccessRequests ResourceAccess
+}
+
+// ClusterDetails combines the cluster with the information the UI needs to decide which
+// functionality to show for it.
+type ClusterDetails struct {
+	*Cluster
+	// AuthSettings are the cluster auth preferences.
+	AuthSettings *webclient.WebConfigAuthSettings
+	// Features are the features enabled on the auth server.
+	Features *proto.Features
+	// Roles are the roles of the logged-in user.
+	Roles []string
+	// Traits are the traits of the logged-in user.
+	Traits map[string][]string
+	// ACL is the access control list of the logged-in user.
+	ACL *ACL
+}
+
+// GetClusterDetails returns the cluster with its auth settings, the features enabled on the auth
+// server and the ACL of the logged-in user.
+func (c *Cluster) GetClusterDetails(ctx context.Context) (*ClusterDetails, error) {
+	webConfig, err := c.clusterClient.GetWebConfig(ctx)
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	var features *proto.Features
+	var acl *ACL
+	err = addMetadataToRetryableError(ctx, func() error {
+		proxyClient, err := c.clusterClient.ConnectToProxy(ctx)
+		if err != nil {
+			return trace.Wrap(err)
+		}
+		defer proxyClient.Close()
+
+		authClient, err := proxyClient.ConnectToCluster(ctx, c.clusterClient.SiteName)
+		if err != nil {
+			return trace.Wrap(err)
+		}
+		defer authClient.Close()
+
+		pingResp, err := authClient.Ping(ctx)
+		if err != nil {
+			return trace.Wrap(err)
+		}
+		features = pingResp.ServerFeatures
+
+		user, err := authClient.GetUser(c.status.Username, false)
+		if err != nil {
+			return trace.Wrap(err)
+		}
+
+		roleSet, err := services.FetchAllClusterRoles(ctx, authClient, c.status.Roles, c.status.Traits)
+		if err != nil {
+			return trace.Wrap(err)
+		}
+
+		acl = newACL(roleSet, &services.Context{User: user})
+
+		return nil
+	})
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	return &ClusterDetails{
+		Cluster:      c,
+		AuthSettings: &webConfig.Auth,
+		Features:     features,
+		Roles:        c.status.Roles,
+		Traits:       c.status.Traits,
+		ACL:          acl,
+	}, nil
+}
+
+// newACL computes the access control list of the user from the role set, kind by kind.
+func newACL(roleSet services.RoleSet, ruleCtx *services.Context) *ACL {
+	return &ACL{
+		Sessions:        newResourceAccess(roleSet, ruleCtx, types.KindSession),
+		AuthConnectors:  newResourceAccess(roleSet, ruleCtx, types.KindAuthConnector),
+		Roles:           newResourceAccess(roleSet, ruleCtx, types.KindRole),
+		Users:           newResourceAccess(roleSet, ruleCtx, types.KindUser),
+		TrustedClusters: newResourceAccess(roleSet, ruleCtx, types.KindTrustedCluster),
+		Events:          newResourceAccess(roleSet, ruleCtx, types.KindEvent),
+		Tokens:          newResourceAccess(roleSet, ruleCtx, types.KindToken),
+		Servers:         newResourceAccess(roleSet, ruleCtx, types.KindNode),
+		Apps:            newResourceAccess(roleSet, ruleCtx, types.KindAppServer),
+		DBs:             newResourceAccess(roleSet, ruleCtx, types.KindDatabaseServer),
+		Kubeservers:     newResourceAccess(roleSet, ruleCtx, types.KindKubeServer),
+		AccessRequests:  newResourceAccess(roleSet, ruleCtx, types.KindAccessRequest),
+	}
+}
+
+func newResourceAccess(roleSet services.RoleSet, ruleCtx *services.Context, kind string) ResourceAccess {
+	hasAccess := func(verb string) bool {
+		return roleSet.CheckAccessToRule(ruleCtx, defaults.Namespace, kind, verb, true) == nil
+	}
+
+	return ResourceAccess{
+		List:   hasAccess(types.VerbList),
+		Read:   hasAccess(types.VerbRead),
+		Edit:   hasAccess(types.VerbUpdate),
+		Create: hasAccess(types.VerbCreate),
+		Delete: hasAccess(types.VerbDelete),
+	}
+}
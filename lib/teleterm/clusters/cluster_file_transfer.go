@@ -0,0 +1,165 @@
+// Copyright 2022 Gravitational, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clusters
+
+import (
+	"context"
+	"io"
+	"os"
+
+	"github.com/gravitational/teleport/api/defaults"
+	"github.com/gravitational/teleport/lib/client"
+
+	"github.com/gravitational/trace"
+	"github.com/pkg/sftp"
+)
+
+// FileTransferParams describe a file transfer to or from a cluster server.
+type FileTransferParams struct {
+	// ServerURI is the URI of the server the file is transferred to or from.
+	ServerURI string
+	// Login is the SSH login used to connect to the server.
+	Login string
+	// Source is the path the file is read from. For uploads it is a local path, for downloads it
+	// is a path on the server.
+	Source string
+	// Destination is the path the file is written to. For uploads it is a path on the server, for
+	// downloads it is a local path.
+	Destination string
+	// Download reads the file from the server when true and writes it to the server otherwise.
+	Download bool
+	// OnProgress, if set, is called after each written chunk with the number of bytes written so
+	// far and the total file size.
+	OnProgress func(written, total int64)
+}
+
+// TransferFile copies a single file between the local machine and a cluster server over SFTP.
+// The transfer is aborted when ctx is canceled.
+func (c *Cluster) TransferFile(ctx context.Context, params FileTransferParams) error {
+	server, err := c.GetServer(ctx, params.ServerURI)
+	if err != nil {
+		return trace.Wrap(err)
+	}
+
+	err = addMetadataToRetryableError(ctx, func() error {
+		proxyClient, err := c.clusterClient.ConnectToProxy(ctx)
+		if err != nil {
+			return trace.Wrap(err)
+		}
+		defer proxyClient.Close()
+
+		nodeClient, err := proxyClient.ConnectToNode(ctx, client.NodeDetails{
+			// Dial the node by UUID so the transfer is not affected by hostname changes.
+			Addr:      server.GetName() + ":0",
+			Namespace: defaults.Namespace,
+			Cluster:   c.clusterClient.SiteName,
+		}, params.Login)
+		if err != nil {
+			return trace.Wrap(err)
+		}
+		defer nodeClient.Close()
+
+		sftpClient, err := sftp.NewClient(nodeClient.Client.Client)
+		if err != nil {
+			return trace.Wrap(err)
+		}
+		defer sftpClient.Close()
+
+		if params.Download {
+			return trace.Wrap(downloadFile(ctx, sftpClient, params))
+		}
+		return trace.Wrap(uploadFile(ctx, sftpClient, params))
+	})
+	return trace.Wrap(err)
+}
+
+func uploadFile(ctx context.Context, sftpClient *sftp.Client, params FileTransferParams) error {
+	source, err := os.Open(params.Source)
+	if err != nil {
+		return trace.ConvertSystemError(err)
+	}
+	defer source.Close()
+
+	info, err := source.Stat()
+	if err != nil {
+		return trace.ConvertSystemError(err)
+	}
+
+	destination, err := sftpClient.Create(params.Destination)
+	if err != nil {
+		return trace.Wrap(err)
+	}
+	defer destination.Close()
+
+	_, err = io.Copy(destination, newFileTransferReader(ctx, source, info.Size(), params.OnProgress))
+	return trace.Wrap(err)
+}
+
+func downloadFile(ctx context.Context, sftpClient *sftp.Client, params FileTransferParams) error {
+	source, err := sftpClient.Open(params.Source)
+	if err != nil {
+		return trace.Wrap(err)
+	}
+	defer source.Close()
+
+	info, err := source.Stat()
+	if err != nil {
+		return trace.Wrap(err)
+	}
+
+	destination, err := os.Create(params.Destination)
+	if err != nil {
+		return trace.ConvertSystemError(err)
+	}
+	defer destination.Close()
+
+	_, err = io.Copy(destination, newFileTransferReader(ctx, source, info.Size(), params.OnProgress))
+	return trace.Wrap(err)
+}
+
+// fileTransferReader wraps the source of a transfer, reporting progress after each read and
+// failing the copy once ctx is canceled.
+type fileTransferReader struct {
+	ctx        context.Context
+	reader     io.Reader
+	total      int64
+	written    int64
+	onProgress func(written, total int64)
+}
+
+func newFileTransferReader(ctx context.Context, reader io.Reader, total int64, onProgress func(written, total int64)) *fileTransferReader {
+	return &fileTransferReader{
+		ctx:        ctx,
+		reader:     reader,
+		total:      total,
+		onProgress: onProgress,
+	}
+}
+
+func (r *fileTransferReader) Read(p []byte) (int, error) {
+	if err := r.ctx.Err(); err != nil {
+		return 0, trace.Wrap(err)
+	}
+
+	n, err := r.reader.Read(p)
+	if n > 0 {
+		r.written += int64(n)
+		if r.onProgress != nil {
+			r.onProgress(r.written, r.total)
+		}
+	}
+
+	return n, err
+}
@@ -0,0 +1,52 @@
+// Copyright 2022 Gravitational, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clusters
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileTransferReaderReportsProgress(t *testing.T) {
+	content := "file transfer content"
+
+	var progress [][2]int64
+	reader := newFileTransferReader(context.Background(), strings.NewReader(content), int64(len(content)), func(written, total int64) {
+		progress = append(progress, [2]int64{written, total})
+	})
+
+	out, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	require.Equal(t, content, string(out))
+
+	require.NotEmpty(t, progress)
+	last := progress[len(progress)-1]
+	require.Equal(t, int64(len(content)), last[0])
+	require.Equal(t, int64(len(content)), last[1])
+}
+
+func TestFileTransferReaderStopsOnCanceledContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	reader := newFileTransferReader(ctx, strings.NewReader("file transfer content"), 0, nil)
+
+	_, err := io.ReadAll(reader)
+	require.ErrorIs(t, err, context.Canceled)
+}
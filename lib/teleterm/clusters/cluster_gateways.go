@@ -18,7 +18,11 @@ package clusters
 
 import (
 	"context"
+	"crypto/tls"
 
+	"github.com/gravitational/teleport/api/utils/keys"
+	"github.com/gravitational/teleport/lib/client"
+	"github.com/gravitational/teleport/lib/teleterm/api/uri"
 	"github.com/gravitational/teleport/lib/teleterm/gateway"
 
 	"github.com/gravitational/trace"
@@ -32,37 +36,142 @@ type CreateGatewayParams struct {
 	// TargetSubresourceName points at a subresource of the remote resource, for example a database
 	// name on a database server.
 	TargetSubresourceName string
+	// LocalAddress is the address the gateway listens on, defaults to localhost
+	LocalAddress string
 	// LocalPort is the gateway local port
 	LocalPort          string
 	CLICommandProvider gateway.CLICommandProvider
 	TCPPortAllocator   gateway.TCPPortAllocator
+	// PromptMFA is called when the target requires a per-session MFA check, presenting the
+	// challenge to the user and returning the response. May be nil, in which case targets
+	// requiring per-session MFA are not reachable.
+	PromptMFA client.PromptMFAChallengeHandler
 }
 
-// CreateGateway creates a gateway
+// CreateGateway creates a gateway to the database, the kube cluster or the app the target URI
+// points at
 func (c *Cluster) CreateGateway(ctx context.Context, params CreateGatewayParams) (*gateway.Gateway, error) {
+	targetURI := uri.New(params.TargetURI)
+
+	if targetURI.GetKubeName() != "" {
+		gw, err := c.createKubeGateway(ctx, params)
+		return gw, trace.Wrap(err)
+	}
+
+	if targetURI.GetAppName() != "" {
+		gw, err := c.createAppGateway(ctx, params)
+		return gw, trace.Wrap(err)
+	}
+
+	gw, err := c.createDatabaseGateway(ctx, params)
+	return gw, trace.Wrap(err)
+}
+
+func (c *Cluster) createDatabaseGateway(ctx context.Context, params CreateGatewayParams) (*gateway.Gateway, error) {
 	db, err := c.GetDatabase(ctx, params.TargetURI)
 	if err != nil {
 		return nil, trace.Wrap(err)
 	}
 
-	if err := c.ReissueDBCerts(ctx, params.TargetUser, db); err != nil {
+	if err := c.ReissueDBCerts(ctx, params.TargetUser, db, params.PromptMFA); err != nil {
 		return nil, trace.Wrap(err)
 	}
 
+	certPath := c.status.DatabaseCertPathForCluster(c.clusterClient.SiteName, db.GetName())
+	keyPath := c.status.KeyPath()
+
 	gw, err := gateway.New(gateway.Config{
+		LocalAddress:          params.LocalAddress,
 		LocalPort:             params.LocalPort,
 		TargetURI:             params.TargetURI,
 		TargetUser:            params.TargetUser,
 		TargetName:            db.GetName(),
 		TargetSubresourceName: params.TargetSubresourceName,
 		Protocol:              db.GetProtocol(),
-		KeyPath:               c.status.KeyPath(),
-		CertPath:              c.status.DatabaseCertPathForCluster(c.clusterClient.SiteName, db.GetName()),
+		KeyPath:               keyPath,
+		CertPath:              certPath,
 		Insecure:              c.clusterClient.InsecureSkipVerify,
 		WebProxyAddr:          c.clusterClient.WebProxyAddr,
 		Log:                   c.Log.WithField("gateway", params.TargetURI),
 		CLICommandProvider:    params.CLICommandProvider,
 		TCPPortAllocator:      params.TCPPortAllocator,
+		CertRenewer: func(ctx context.Context) (tls.Certificate, error) {
+			if err := c.ReissueDBCerts(ctx, params.TargetUser, db, params.PromptMFA); err != nil {
+				return tls.Certificate{}, trace.Wrap(err)
+			}
+
+			tlsCert, err := keys.LoadX509KeyPair(certPath, keyPath)
+			return tlsCert, trace.Wrap(err)
+		},
+	})
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	return gw, nil
+}
+
+func (c *Cluster) createAppGateway(ctx context.Context, params CreateGatewayParams) (*gateway.Gateway, error) {
+	app, err := c.GetApp(ctx, params.TargetURI)
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	if err := c.ReissueAppCerts(ctx, app); err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	protocol := gateway.ProtocolAppHTTP
+	if app.IsTCP() {
+		protocol = gateway.ProtocolAppTCP
+	}
+
+	gw, err := gateway.New(gateway.Config{
+		LocalAddress:       params.LocalAddress,
+		LocalPort:          params.LocalPort,
+		TargetURI:          params.TargetURI,
+		TargetName:         app.GetName(),
+		Protocol:           protocol,
+		KeyPath:            c.status.KeyPath(),
+		CertPath:           c.status.AppCertPath(app.GetName()),
+		Insecure:           c.clusterClient.InsecureSkipVerify,
+		WebProxyAddr:       c.clusterClient.WebProxyAddr,
+		Log:                c.Log.WithField("gateway", params.TargetURI),
+		CLICommandProvider: params.CLICommandProvider,
+		TCPPortAllocator:   params.TCPPortAllocator,
+	})
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	return gw, nil
+}
+
+func (c *Cluster) createKubeGateway(ctx context.Context, params CreateGatewayParams) (*gateway.Gateway, error) {
+	kube, err := c.GetKube(ctx, params.TargetURI)
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	kubeName := kube.KubernetesCluster.GetName()
+	if err := c.ReissueKubeCerts(ctx, kubeName); err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	gw, err := gateway.New(gateway.Config{
+		LocalAddress:       params.LocalAddress,
+		LocalPort:          params.LocalPort,
+		TargetURI:          params.TargetURI,
+		TargetName:         kubeName,
+		Protocol:           gateway.ProtocolKube,
+		KeyPath:            c.status.KeyPath(),
+		CertPath:           c.status.KubeCertPathForCluster(c.clusterClient.SiteName, kubeName),
+		KubeconfigPath:     c.status.KubeConfigPath(kubeName),
+		Insecure:           c.clusterClient.InsecureSkipVerify,
+		WebProxyAddr:       c.clusterClient.WebProxyAddr,
+		Log:                c.Log.WithField("gateway", params.TargetURI),
+		CLICommandProvider: params.CLICommandProvider,
+		TCPPortAllocator:   params.TCPPortAllocator,
 	})
 	if err != nil {
 		return nil, trace.Wrap(err)
@@ -21,6 +21,7 @@ import (
 
 	"github.com/gravitational/teleport/api/types"
 	"github.com/gravitational/teleport/lib/auth"
+	"github.com/gravitational/teleport/lib/client"
 	"github.com/gravitational/teleport/lib/teleterm/api/uri"
 
 	"github.com/gravitational/trace"
@@ -34,6 +35,22 @@ type Kube struct {
 	KubernetesCluster types.KubeCluster
 }
 
+// GetKube returns a kube cluster
+func (c *Cluster) GetKube(ctx context.Context, kubeURI string) (*Kube, error) {
+	kubes, err := c.GetKubes(ctx)
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	for _, kube := range kubes {
+		if kube.URI.String() == kubeURI {
+			return &kube, nil
+		}
+	}
+
+	return nil, trace.NotFound("kube cluster is not found: %v", kubeURI)
+}
+
 // GetKubes returns kube services
 func (c *Cluster) GetKubes(ctx context.Context) ([]Kube, error) {
 	var authClient auth.ClientI
@@ -83,3 +100,34 @@ func (c *Cluster) GetKubes(ctx context.Context) ([]Kube, error) {
 
 	return kubes, nil
 }
+
+// ReissueKubeCerts issues new certificates for specific kube cluster access
+func (c *Cluster) ReissueKubeCerts(ctx context.Context, kubeName string) error {
+	err := addMetadataToRetryableError(ctx, func() error {
+		// Refresh the certs to account for clusterClient.SiteName pointing at a leaf cluster.
+		err := c.clusterClient.ReissueUserCerts(ctx, client.CertCacheKeep, client.ReissueParams{
+			RouteToCluster: c.clusterClient.SiteName,
+			AccessRequests: c.status.ActiveRequests.AccessRequests,
+		})
+		if err != nil {
+			return trace.Wrap(err)
+		}
+
+		// Fetch the certs for the kube cluster.
+		err = c.clusterClient.ReissueUserCerts(ctx, client.CertCacheKeep, client.ReissueParams{
+			RouteToCluster:    c.clusterClient.SiteName,
+			KubernetesCluster: kubeName,
+			AccessRequests:    c.status.ActiveRequests.AccessRequests,
+		})
+		if err != nil {
+			return trace.Wrap(err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return trace.Wrap(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,136 @@
+// Copyright 2022 Gravitational, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clusters
+
+import (
+	"context"
+	"time"
+
+	"github.com/gravitational/teleport/api/types"
+	apievents "github.com/gravitational/teleport/api/types/events"
+	"github.com/gravitational/teleport/lib/session"
+
+	"github.com/gravitational/trace"
+)
+
+// defaultRecordingsLimit caps how many recordings a single GetRecordings page returns unless the
+// caller asks for less.
+const defaultRecordingsLimit = 50
+
+// Recording describes a recorded session.
+type Recording struct {
+	// SessionID identifies the recorded session.
+	SessionID string
+	// Hostname is the name of the server the session ran on.
+	Hostname string
+	// User is the Teleport user who started the session.
+	User string
+	// Participants are all the users who joined the session.
+	Participants []string
+	// StartTime and EndTime delimit the session.
+	StartTime time.Time
+	EndTime   time.Time
+	// Interactive is true for sessions with a PTY attached, false for exec sessions.
+	Interactive bool
+}
+
+// GetRecordingsParams describe a page of the recordings list.
+type GetRecordingsParams struct {
+	// FromUTC and ToUTC delimit the searched time range.
+	FromUTC time.Time
+	ToUTC   time.Time
+	// Limit caps the page size, zero means defaultRecordingsLimit.
+	Limit int
+	// StartKey is the key returned with the previous page, empty for the first page.
+	StartKey string
+}
+
+// GetRecordings returns a page of recorded sessions, newest first, along with the key of the
+// next page. An empty key means there are no more pages.
+func (c *Cluster) GetRecordings(ctx context.Context, params GetRecordingsParams) ([]Recording, string, error) {
+	limit := params.Limit
+	if limit <= 0 {
+		limit = defaultRecordingsLimit
+	}
+
+	var sessionEvents []apievents.AuditEvent
+	var nextKey string
+	err := addMetadataToRetryableError(ctx, func() error {
+		proxyClient, err := c.clusterClient.ConnectToProxy(ctx)
+		if err != nil {
+			return trace.Wrap(err)
+		}
+		defer proxyClient.Close()
+
+		authClient, err := proxyClient.ConnectToCluster(ctx, c.clusterClient.SiteName)
+		if err != nil {
+			return trace.Wrap(err)
+		}
+		defer authClient.Close()
+
+		sessionEvents, nextKey, err = authClient.SearchSessionEvents(
+			params.FromUTC, params.ToUTC, limit, types.EventOrderDescending, params.StartKey, nil, "")
+		return trace.Wrap(err)
+	})
+	if err != nil {
+		return nil, "", trace.Wrap(err)
+	}
+
+	recordings := []Recording{}
+	for _, event := range sessionEvents {
+		sessionEnd, ok := event.(*apievents.SessionEnd)
+		if !ok {
+			continue
+		}
+		recordings = append(recordings, Recording{
+			SessionID:    sessionEnd.SessionID,
+			Hostname:     sessionEnd.ServerHostname,
+			User:         sessionEnd.User,
+			Participants: sessionEnd.Participants,
+			StartTime:    sessionEnd.StartTime,
+			EndTime:      sessionEnd.EndTime,
+			Interactive:  sessionEnd.Interactive,
+		})
+	}
+
+	return recordings, nextKey, nil
+}
+
+// StreamRecording streams the events of a recorded session starting at the given event index.
+// Seeking is done by restarting the stream at a different index. The returned channels follow
+// the auth client contract: the event channel is closed once the recording ends and errors
+// surface on the error channel. The underlying connections are released once ctx is canceled.
+func (c *Cluster) StreamRecording(ctx context.Context, sessionID string, startIndex int64) (chan apievents.AuditEvent, chan error, error) {
+	proxyClient, err := c.clusterClient.ConnectToProxy(ctx)
+	if err != nil {
+		return nil, nil, trace.Wrap(err)
+	}
+
+	authClient, err := proxyClient.ConnectToCluster(ctx, c.clusterClient.SiteName)
+	if err != nil {
+		proxyClient.Close()
+		return nil, nil, trace.Wrap(err)
+	}
+
+	eventsC, errC := authClient.StreamSessionEvents(ctx, session.ID(sessionID), startIndex)
+
+	go func() {
+		<-ctx.Done()
+		authClient.Close()
+		proxyClient.Close()
+	}()
+
+	return eventsC, errC, nil
+}
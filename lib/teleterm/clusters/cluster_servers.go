@@ -35,6 +35,22 @@ type Server struct {
 	types.Server
 }
 
+// GetServer returns a cluster server
+func (c *Cluster) GetServer(ctx context.Context, serverURI string) (*Server, error) {
+	servers, err := c.GetServers(ctx)
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	for _, server := range servers {
+		if server.URI.String() == serverURI {
+			return &server, nil
+		}
+	}
+
+	return nil, trace.NotFound("server is not found: %v", serverURI)
+}
+
 // GetServers returns cluster servers
 func (c *Cluster) GetServers(ctx context.Context) ([]Server, error) {
 	var clusterServers []types.Server
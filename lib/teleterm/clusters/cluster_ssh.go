@@ -0,0 +1,138 @@
+// Copyright 2022 Gravitational, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clusters
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/gravitational/teleport"
+	"github.com/gravitational/teleport/api/defaults"
+	tracessh "github.com/gravitational/teleport/api/observability/tracing/ssh"
+	"github.com/gravitational/teleport/lib/client"
+
+	"github.com/gravitational/trace"
+	"golang.org/x/crypto/ssh"
+)
+
+// SSHSessionParams describe an interactive shell opened on a cluster server.
+type SSHSessionParams struct {
+	// ServerURI is the URI of the server the shell runs on.
+	ServerURI string
+	// Login is the SSH login used to connect to the server.
+	Login string
+	// Term is the terminal type requested for the PTY. Defaults to teleport.SafeTerminalType.
+	Term string
+	// Width and Height are the initial PTY dimensions.
+	Width  int
+	Height int
+	// Stdin is streamed to the shell.
+	Stdin io.Reader
+	// Stdout and Stderr receive the PTY output as it arrives.
+	Stdout io.Writer
+	Stderr io.Writer
+}
+
+// SSHSession is an interactive shell running on a cluster server.
+type SSHSession struct {
+	session     *tracessh.Session
+	nodeClient  *client.NodeClient
+	proxyClient *client.ProxyClient
+}
+
+// ConnectToServer opens an interactive shell on the server the params point at. The returned
+// session is ready to use; the caller is expected to Wait for the exit status and Close the
+// session when done.
+func (c *Cluster) ConnectToServer(ctx context.Context, params SSHSessionParams) (*SSHSession, error) {
+	server, err := c.GetServer(ctx, params.ServerURI)
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	proxyClient, err := c.clusterClient.ConnectToProxy(ctx)
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	nodeClient, err := proxyClient.ConnectToNode(ctx, client.NodeDetails{
+		// Dial the node by UUID so the session is not affected by hostname changes.
+		Addr:      server.GetName() + ":0",
+		Namespace: defaults.Namespace,
+		Cluster:   c.clusterClient.SiteName,
+	}, params.Login)
+	if err != nil {
+		proxyClient.Close()
+		return nil, trace.Wrap(err)
+	}
+
+	session, err := nodeClient.Client.NewSession(ctx)
+	if err != nil {
+		nodeClient.Close()
+		proxyClient.Close()
+		return nil, trace.Wrap(err)
+	}
+
+	session.Stdin = params.Stdin
+	session.Stdout = params.Stdout
+	session.Stderr = params.Stderr
+
+	term := params.Term
+	if term == "" {
+		term = teleport.SafeTerminalType
+	}
+
+	err = session.RequestPty(ctx, term, params.Height, params.Width, ssh.TerminalModes{})
+	if err == nil {
+		err = session.Shell(ctx)
+	}
+	if err != nil {
+		session.Close()
+		nodeClient.Close()
+		proxyClient.Close()
+		return nil, trace.Wrap(err)
+	}
+
+	return &SSHSession{
+		session:     session,
+		nodeClient:  nodeClient,
+		proxyClient: proxyClient,
+	}, nil
+}
+
+// Resize changes the PTY dimensions.
+func (s *SSHSession) Resize(ctx context.Context, width, height int) error {
+	return trace.Wrap(s.session.WindowChange(ctx, height, width))
+}
+
+// Wait blocks until the shell exits and returns its exit code.
+func (s *SSHSession) Wait() (int, error) {
+	err := s.session.Wait()
+	var exitErr *ssh.ExitError
+	if errors.As(err, &exitErr) {
+		return exitErr.ExitStatus(), nil
+	}
+	if err != nil {
+		return 0, trace.Wrap(err)
+	}
+	return 0, nil
+}
+
+// Close terminates the session and releases the underlying connections.
+func (s *SSHSession) Close() error {
+	s.session.Close()
+	s.nodeClient.Close()
+	return trace.Wrap(s.proxyClient.Close())
+}
@@ -0,0 +1,100 @@
+// Copyright 2022 Gravitational, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clusters
+
+import (
+	"context"
+
+	"github.com/gravitational/teleport/api/types"
+
+	"github.com/gravitational/trace"
+)
+
+// WatchResources subscribes to changes of the cluster resources shown in the UI: servers,
+// database servers, kube services and app servers. The caller consumes watcher.Events() and is
+// expected to close the watcher when done; the underlying connections are released once the
+// watcher is closed.
+func (c *Cluster) WatchResources(ctx context.Context) (types.Watcher, error) {
+	proxyClient, err := c.clusterClient.ConnectToProxy(ctx)
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	authClient, err := proxyClient.ConnectToCluster(ctx, c.clusterClient.SiteName)
+	if err != nil {
+		proxyClient.Close()
+		return nil, trace.Wrap(err)
+	}
+
+	watcher, err := authClient.NewWatcher(ctx, types.Watch{
+		Name: "teleterm-resources",
+		Kinds: []types.WatchKind{
+			{Kind: types.KindNode},
+			{Kind: types.KindDatabaseServer},
+			{Kind: types.KindKubeService},
+			{Kind: types.KindAppServer},
+		},
+	})
+	if err != nil {
+		authClient.Close()
+		proxyClient.Close()
+		return nil, trace.Wrap(err)
+	}
+
+	go func() {
+		<-watcher.Done()
+		authClient.Close()
+		proxyClient.Close()
+	}()
+
+	return watcher, nil
+}
+
+// WatchAccessRequests subscribes to changes of the access requests concerning the logged-in
+// user, both the ones they created and the ones they can review. The caller consumes
+// watcher.Events() and is expected to close the watcher when done.
+func (c *Cluster) WatchAccessRequests(ctx context.Context) (types.Watcher, error) {
+	proxyClient, err := c.clusterClient.ConnectToProxy(ctx)
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	authClient, err := proxyClient.ConnectToCluster(ctx, c.clusterClient.SiteName)
+	if err != nil {
+		proxyClient.Close()
+		return nil, trace.Wrap(err)
+	}
+
+	filter := types.AccessRequestFilter{User: c.status.Username}
+	watcher, err := authClient.NewWatcher(ctx, types.Watch{
+		Name: "teleterm-access-requests",
+		Kinds: []types.WatchKind{
+			{Kind: types.KindAccessRequest, Filter: filter.IntoMap()},
+		},
+	})
+	if err != nil {
+		authClient.Close()
+		proxyClient.Close()
+		return nil, trace.Wrap(err)
+	}
+
+	go func() {
+		<-watcher.Done()
+		authClient.Close()
+		proxyClient.Close()
+	}()
+
+	return watcher, nil
+}
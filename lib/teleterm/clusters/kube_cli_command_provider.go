@@ -0,0 +1,40 @@
+// Copyright 2022 Gravitational, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clusters
+
+import (
+	"fmt"
+
+	"github.com/gravitational/teleport/lib/teleterm/gateway"
+
+	"github.com/gravitational/trace"
+)
+
+// KubeCLICommandProvider provides CLI commands for kube gateways. It points kubectl at the
+// ephemeral kubeconfig written by the gateway.
+type KubeCLICommandProvider struct{}
+
+func NewKubeCLICommandProvider() KubeCLICommandProvider {
+	return KubeCLICommandProvider{}
+}
+
+func (k KubeCLICommandProvider) GetCommand(gateway *gateway.Gateway) (string, error) {
+	kubeconfigPath := gateway.KubeconfigPath()
+	if kubeconfigPath == "" {
+		return "", trace.BadParameter("gateway %v is not a kube gateway", gateway.URI().String())
+	}
+
+	return fmt.Sprintf("kubectl --kubeconfig %s", kubeconfigPath), nil
+}
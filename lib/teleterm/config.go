@@ -35,6 +35,10 @@ type Config struct {
 	CertsDir string
 	// InsecureSkipVerify is an option to skip HTTPS cert check
 	InsecureSkipVerify bool
+	// TshdEventsServerAddr is the address of the tshd events service served by the Electron app.
+	// May be empty, in which case the features requiring user interaction initiated by the
+	// daemon, such as relogin prompts, are disabled.
+	TshdEventsServerAddr string
 }
 
 // CheckAndSetDefaults checks and sets default config values.
@@ -0,0 +1,171 @@
+// Copyright 2022 Gravitational, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package daemon
+
+import (
+	"context"
+	"encoding/binary"
+	"net"
+	"net/url"
+	"strings"
+
+	"github.com/gravitational/teleport/lib/teleterm/clusters"
+
+	"github.com/gravitational/trace"
+)
+
+// appLoopbackAddrBase is the first loopback address handed out to TCP apps. The 127.0.100.0
+// range is unlikely to collide with other local services.
+const appLoopbackAddrBase = "127.0.100.2"
+
+// appRoute ties the public hostname of a TCP app to the loopback address allocated for it and
+// the gateway serving it.
+type appRoute struct {
+	// localAddress is the loopback address allocated for the app.
+	localAddress string
+	// gatewayURI is the URI of the gateway serving the app, set once the gateway is created.
+	gatewayURI string
+}
+
+// ResolveApp returns the loopback address allocated for the TCP app with the given public
+// hostname, allocating the address and creating a gateway bound to it on demand. Local DNS uses
+// the returned address to answer queries for the hostname, giving TCP apps stable local
+// addresses that route to the right gateway.
+func (s *Service) ResolveApp(ctx context.Context, hostname string) (string, error) {
+	hostname = strings.ToLower(strings.TrimSuffix(hostname, "."))
+	if hostname == "" {
+		return "", trace.BadParameter("missing hostname")
+	}
+
+	s.mu.RLock()
+	route, ok := s.appRoutes[hostname]
+	s.mu.RUnlock()
+	if ok {
+		return route.localAddress, nil
+	}
+
+	app, err := s.findTCPApp(ctx, hostname)
+	if err != nil {
+		return "", trace.Wrap(err)
+	}
+
+	localPort, err := appLocalPort(*app)
+	if err != nil {
+		return "", trace.Wrap(err)
+	}
+
+	s.mu.Lock()
+	// The app lookup dropped the lock, another resolution may have won the race.
+	if route, ok := s.appRoutes[hostname]; ok {
+		s.mu.Unlock()
+		return route.localAddress, nil
+	}
+	localAddress, err := s.nextAppLoopbackAddrLocked()
+	if err != nil {
+		s.mu.Unlock()
+		return "", trace.Wrap(err)
+	}
+	route = &appRoute{localAddress: localAddress}
+	s.appRoutes[hostname] = route
+	s.mu.Unlock()
+
+	gateway, err := s.CreateGateway(ctx, CreateGatewayParams{
+		TargetURI:    app.URI.String(),
+		LocalAddress: localAddress,
+		LocalPort:    localPort,
+	})
+	if err != nil {
+		s.mu.Lock()
+		delete(s.appRoutes, hostname)
+		s.mu.Unlock()
+		return "", trace.Wrap(err)
+	}
+
+	s.mu.Lock()
+	route.gatewayURI = gateway.URI().String()
+	s.mu.Unlock()
+
+	return localAddress, nil
+}
+
+// findTCPApp looks for a TCP app with the given public hostname across all connected clusters.
+func (s *Service) findTCPApp(ctx context.Context, hostname string) (*clusters.App, error) {
+	cs, err := s.cfg.Storage.ReadAll()
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	for _, cluster := range cs {
+		if !cluster.Connected() {
+			continue
+		}
+
+		apps, err := cluster.GetApps(ctx)
+		if err != nil {
+			s.cfg.Log.WithError(err).Warnf("Failed to fetch apps from cluster %v.", cluster.URI)
+			continue
+		}
+
+		for _, app := range apps {
+			if !app.IsTCP() {
+				continue
+			}
+			publicHost := app.GetPublicAddr()
+			if host, _, err := net.SplitHostPort(publicHost); err == nil {
+				publicHost = host
+			}
+			if strings.EqualFold(publicHost, hostname) {
+				app := app
+				return &app, nil
+			}
+		}
+	}
+
+	return nil, trace.NotFound("no TCP app with public hostname %v", hostname)
+}
+
+// nextAppLoopbackAddrLocked allocates the next free loopback address for a TCP app. Requires
+// s.mu to be held.
+func (s *Service) nextAppLoopbackAddrLocked() (string, error) {
+	base := binary.BigEndian.Uint32(net.ParseIP(appLoopbackAddrBase).To4())
+	next := base + s.appAddrOffset
+	// Stay within 127.0.0.0/8.
+	if next>>24 != 127 {
+		return "", trace.LimitExceeded("ran out of loopback addresses for TCP apps")
+	}
+	s.appAddrOffset++
+
+	ip := make(net.IP, net.IPv4len)
+	binary.BigEndian.PutUint32(ip, next)
+	return ip.String(), nil
+}
+
+// appLocalPort returns the port the app gateway should listen on, so clients can keep using the
+// port they would use with the public address.
+func appLocalPort(app clusters.App) (string, error) {
+	if _, port, err := net.SplitHostPort(app.GetPublicAddr()); err == nil {
+		return port, nil
+	}
+
+	// Fall back to the port of the address the app service proxies.
+	appURL, err := url.Parse(app.GetURI())
+	if err != nil {
+		return "", trace.Wrap(err, "failed to parse the URI of app %v", app.GetName())
+	}
+	if appURL.Port() == "" {
+		return "", trace.BadParameter("cannot determine the local port for app %v", app.GetName())
+	}
+	return appURL.Port(), nil
+}
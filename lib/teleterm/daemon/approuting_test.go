@@ -0,0 +1,84 @@
+// Copyright 2022 Gravitational, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package daemon
+
+import (
+	"testing"
+
+	"github.com/gravitational/teleport/api/types"
+	"github.com/gravitational/teleport/lib/teleterm/clusters"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNextAppLoopbackAddr(t *testing.T) {
+	s := &Service{}
+
+	first, err := s.nextAppLoopbackAddrLocked()
+	require.NoError(t, err)
+	require.Equal(t, "127.0.100.2", first)
+
+	second, err := s.nextAppLoopbackAddrLocked()
+	require.NoError(t, err)
+	require.Equal(t, "127.0.100.3", second)
+}
+
+func TestAppLocalPort(t *testing.T) {
+	tests := []struct {
+		name       string
+		publicAddr string
+		uri        string
+		port       string
+		wantErr    bool
+	}{
+		{
+			name:       "port from public address",
+			publicAddr: "tcp-app.teleport.example.com:5432",
+			uri:        "tcp://localhost:4242",
+			port:       "5432",
+		},
+		{
+			name:       "port from app URI",
+			publicAddr: "tcp-app.teleport.example.com",
+			uri:        "tcp://localhost:4242",
+			port:       "4242",
+		},
+		{
+			name:       "no port to be found",
+			publicAddr: "tcp-app.teleport.example.com",
+			uri:        "tcp://localhost",
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			app, err := types.NewAppV3(types.Metadata{Name: "tcp-app"}, types.AppSpecV3{
+				URI:        tt.uri,
+				PublicAddr: tt.publicAddr,
+			})
+			require.NoError(t, err)
+
+			port, err := appLocalPort(clusters.App{Application: app})
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.port, port)
+		})
+	}
+}
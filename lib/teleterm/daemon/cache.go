@@ -0,0 +1,97 @@
+// Copyright 2022 Gravitational, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package daemon
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// resourceCacheTTL is how long a cached response stays valid. The TTL is short on purpose – the
+// cache exists to absorb rapid UI navigation, not to make the resource lists stale.
+const resourceCacheTTL = 10 * time.Second
+
+type cacheEntry struct {
+	value     interface{}
+	expiresAt time.Time
+}
+
+// responseCache is a small TTL cache for responses of expensive cluster calls, keyed by the
+// resource URI and the kind of the call.
+type responseCache struct {
+	mu      sync.Mutex
+	entries map[string]cacheEntry
+	clock   func() time.Time
+}
+
+func newResponseCache() *responseCache {
+	return &responseCache{
+		entries: make(map[string]cacheEntry),
+		clock:   time.Now,
+	}
+}
+
+// cacheKey builds the cache key for a call of the given kind concerning the given resource URI.
+// Keys start with the resource URI so all entries of a cluster can be invalidated by prefix.
+func cacheKey(resourceURI, kind string) string {
+	return fmt.Sprintf("%v#%v", resourceURI, kind)
+}
+
+func (c *responseCache) get(key string) (interface{}, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if c.clock().After(entry.expiresAt) {
+		delete(c.entries, key)
+		return nil, false
+	}
+
+	return entry.value, true
+}
+
+func (c *responseCache) put(key string, value interface{}) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries[key] = cacheEntry{
+		value:     value,
+		expiresAt: c.clock().Add(resourceCacheTTL),
+	}
+}
+
+// invalidate removes all entries whose resource URI starts with the given URI, e.g. passing a
+// cluster URI drops the cached responses of the whole cluster.
+func (c *responseCache) invalidate(resourceURI string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for key := range c.entries {
+		if strings.HasPrefix(key, resourceURI) {
+			delete(c.entries, key)
+		}
+	}
+}
+
+// InvalidateCachedResources drops the cached responses for the cluster or resource the URI points
+// at, forcing the next list call to hit the auth server.
+func (s *Service) InvalidateCachedResources(resourceURI string) {
+	s.cache.invalidate(resourceURI)
+}
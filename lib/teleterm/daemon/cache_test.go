@@ -0,0 +1,59 @@
+// Copyright 2022 Gravitational, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package daemon
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestResponseCacheExpiresEntries(t *testing.T) {
+	now := time.Now()
+	cache := newResponseCache()
+	cache.clock = func() time.Time { return now }
+
+	key := cacheKey("/clusters/foo", "servers")
+	cache.put(key, "servers-response")
+
+	value, ok := cache.get(key)
+	require.True(t, ok)
+	require.Equal(t, "servers-response", value)
+
+	now = now.Add(resourceCacheTTL + time.Second)
+
+	_, ok = cache.get(key)
+	require.False(t, ok)
+}
+
+func TestResponseCacheInvalidatesByPrefix(t *testing.T) {
+	cache := newResponseCache()
+
+	cache.put(cacheKey("/clusters/foo", "servers"), "foo-servers")
+	cache.put(cacheKey("/clusters/foo/dbs/one", "db-users"), "foo-db-users")
+	cache.put(cacheKey("/clusters/bar", "servers"), "bar-servers")
+
+	cache.invalidate("/clusters/foo")
+
+	_, ok := cache.get(cacheKey("/clusters/foo", "servers"))
+	require.False(t, ok)
+	_, ok = cache.get(cacheKey("/clusters/foo/dbs/one", "db-users"))
+	require.False(t, ok)
+
+	value, ok := cache.get(cacheKey("/clusters/bar", "servers"))
+	require.True(t, ok)
+	require.Equal(t, "bar-servers", value)
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package daemon
 
 import (
+	"github.com/gravitational/teleport/lib/client"
 	"github.com/gravitational/teleport/lib/teleterm/clusters"
 	"github.com/gravitational/teleport/lib/teleterm/gateway"
 
@@ -33,6 +34,24 @@ type Config struct {
 	Log              *logrus.Entry
 	GatewayCreator   GatewayCreator
 	TCPPortAllocator gateway.TCPPortAllocator
+	// PromptMFA is called when a connection is waiting on a per-session MFA check. The tshd
+	// events service implementation forwards the challenge to the Electron app and blocks until
+	// the user responds. May be nil, in which case connections requiring per-session MFA fail.
+	PromptMFA client.PromptMFAChallengeHandler
+	// SubmitUsageEvents submits batches of usage events to the reporting endpoint. May be nil, in
+	// which case no events are collected.
+	SubmitUsageEvents SubmitUsageEventsFunc
+	// SendNotification delivers daemon-originated notifications (access request updates, cert
+	// expiry warnings) to the Electron app over the tshd events service. May be nil, in which
+	// case no notifications are emitted.
+	SendNotification SendNotificationFunc
+	// Relogin asks the Electron app to log the user into a root cluster when an operation fails
+	// due to expired certs, so the operation can be retried transparently. May be nil, in which
+	// case such errors are surfaced to the caller right away.
+	Relogin ReloginFunc
+	// DisableUsageReporting turns off the collection of usage events. Reporting is on by default
+	// when SubmitUsageEvents is set; the user can opt out through the app config.
+	DisableUsageReporting bool
 }
 
 // CheckAndSetDefaults checks the configuration for its validity and sets default values if needed
@@ -16,9 +16,13 @@ package daemon
 
 import (
 	"context"
+	"strings"
 	"sync"
 
+	"github.com/gravitational/teleport/api/types"
+	apievents "github.com/gravitational/teleport/api/types/events"
 	"github.com/gravitational/teleport/lib/client/db/dbcmd"
+	"github.com/gravitational/teleport/lib/teleterm/api/uri"
 	"github.com/gravitational/teleport/lib/teleterm/clusters"
 	"github.com/gravitational/teleport/lib/teleterm/gateway"
 
@@ -31,10 +35,18 @@ func New(cfg Config) (*Service, error) {
 		return nil, trace.Wrap(err)
 	}
 
-	return &Service{
-		cfg:      &cfg,
-		gateways: make(map[string]*gateway.Gateway),
-	}, nil
+	service := &Service{
+		cfg:       &cfg,
+		gateways:  make(map[string]*gateway.Gateway),
+		appRoutes: make(map[string]*appRoute),
+		cache:     newResponseCache(),
+	}
+
+	if cfg.SubmitUsageEvents != nil && !cfg.DisableUsageReporting {
+		service.usageReporter = newUsageReporter(cfg.SubmitUsageEvents, cfg.Log)
+	}
+
+	return service, nil
 }
 
 // ListRootClusters returns a list of root clusters
@@ -94,9 +106,75 @@ func (s *Service) RemoveCluster(ctx context.Context, uri string) error {
 		return trace.Wrap(err)
 	}
 
+	s.cleanUpClusterState(cluster.URI.String())
+
 	return nil
 }
 
+// cleanUpClusterState closes the gateways of a cluster (including its leaf clusters) and removes
+// the persisted daemon state tied to it, so logging out doesn't leave orphaned gateways,
+// favorites or recent connections behind.
+func (s *Service) cleanUpClusterState(clusterURI string) {
+	prefix := clusterURI + "/"
+	belongsToCluster := func(resourceURI string) bool {
+		return strings.HasPrefix(resourceURI, prefix)
+	}
+
+	s.cache.invalidate(clusterURI)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, gateway := range s.gateways {
+		if !belongsToCluster(gateway.TargetURI()) {
+			continue
+		}
+		if err := s.removeGateway(gateway); err != nil {
+			s.cfg.Log.WithError(err).Warnf("Failed to close the gateway for %v.", gateway.TargetURI())
+		}
+	}
+	s.persistGateways()
+
+	// Drop the app routes whose gateways are now gone.
+	for hostname, route := range s.appRoutes {
+		if _, ok := s.gateways[route.gatewayURI]; !ok {
+			delete(s.appRoutes, hostname)
+		}
+	}
+
+	if favorites, err := s.loadFavorites(); err == nil {
+		remaining := favorites[:0]
+		for _, favorite := range favorites {
+			if !belongsToCluster(favorite) {
+				remaining = append(remaining, favorite)
+			}
+		}
+		if len(remaining) != len(favorites) {
+			if err := s.saveFavorites(remaining); err != nil {
+				s.cfg.Log.WithError(err).Warn("Failed to persist the favorites.")
+			}
+		}
+	} else {
+		s.cfg.Log.WithError(err).Warn("Failed to read the favorites.")
+	}
+
+	if connections, err := s.loadRecentConnections(); err == nil {
+		remaining := connections[:0]
+		for _, connection := range connections {
+			if !belongsToCluster(connection.ResourceURI) {
+				remaining = append(remaining, connection)
+			}
+		}
+		if len(remaining) != len(connections) {
+			if err := s.saveRecentConnections(remaining); err != nil {
+				s.cfg.Log.WithError(err).Warn("Failed to persist the recent connections.")
+			}
+		}
+	} else {
+		s.cfg.Log.WithError(err).Warn("Failed to read the recent connections.")
+	}
+}
+
 // ResolveCluster resolves a cluster by URI
 func (s *Service) ResolveCluster(uri string) (*clusters.Cluster, error) {
 	cluster, err := s.cfg.Storage.GetByResourceURI(uri)
@@ -107,6 +185,22 @@ func (s *Service) ResolveCluster(uri string) (*clusters.Cluster, error) {
 	return cluster, nil
 }
 
+// GetClusterDetails returns the cluster with its auth settings, enabled features and the ACL of
+// the logged-in user, so the UI can hide functionality unavailable in the cluster
+func (s *Service) GetClusterDetails(ctx context.Context, clusterURI string) (*clusters.ClusterDetails, error) {
+	cluster, err := s.ResolveCluster(clusterURI)
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	details, err := cluster.GetClusterDetails(ctx)
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	return details, nil
+}
+
 // ClusterLogout logs a user out from the cluster
 func (s *Service) ClusterLogout(ctx context.Context, uri string) error {
 	cluster, err := s.ResolveCluster(uri)
@@ -118,19 +212,48 @@ func (s *Service) ClusterLogout(ctx context.Context, uri string) error {
 		return trace.Wrap(err)
 	}
 
+	s.cleanUpClusterState(cluster.URI.String())
+
 	return nil
 }
 
 // CreateGateway creates a gateway to given targetURI
 func (s *Service) CreateGateway(ctx context.Context, params CreateGatewayParams) (*gateway.Gateway, error) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
-
-	gateway, err := s.createGateway(ctx, params)
+	// Relogin blocks on user interaction, so the mutex is only held around
+	// the gateway creation itself. Holding it across the whole retry would
+	// lock out every other daemon method until the user responds.
+	var gateway *gateway.Gateway
+	err := s.retryWithRelogin(ctx, params.TargetURI, func() error {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		var err error
+		gateway, err = s.createGateway(ctx, params)
+		return trace.Wrap(err)
+	})
 	if err != nil {
 		return nil, trace.Wrap(err)
 	}
 
+	connectionParams := map[string]string{}
+	if params.TargetUser != "" {
+		connectionParams["target_user"] = params.TargetUser
+	}
+	if params.TargetSubresourceName != "" {
+		connectionParams["target_subresource_name"] = params.TargetSubresourceName
+	}
+
+	s.mu.Lock()
+	s.persistGateways()
+	s.recordRecentConnectionLocked(params.TargetURI, connectionParams)
+	s.mu.Unlock()
+
+	if cluster, err := s.ResolveCluster(params.TargetURI); err == nil {
+		s.reportUsageEvent(cluster.URI.String(), "connect.gateway.create", map[string]string{
+			"target_uri": params.TargetURI,
+		})
+	}
+
 	return gateway, nil
 }
 
@@ -140,14 +263,24 @@ type GatewayCreator interface {
 
 // createGateway assumes that mu is already held by a public method.
 func (s *Service) createGateway(ctx context.Context, params CreateGatewayParams) (*gateway.Gateway, error) {
-	cliCommandProvider := clusters.NewDbcmdCLICommandProvider(s.cfg.Storage, dbcmd.SystemExecer{})
+	targetURI := uri.New(params.TargetURI)
+
+	var cliCommandProvider gateway.CLICommandProvider = clusters.NewDbcmdCLICommandProvider(s.cfg.Storage, dbcmd.SystemExecer{})
+	switch {
+	case targetURI.GetKubeName() != "":
+		cliCommandProvider = clusters.NewKubeCLICommandProvider()
+	case targetURI.GetAppName() != "":
+		cliCommandProvider = clusters.NewAppCLICommandProvider()
+	}
 	clusterCreateGatewayParams := clusters.CreateGatewayParams{
 		TargetURI:             params.TargetURI,
 		TargetUser:            params.TargetUser,
 		TargetSubresourceName: params.TargetSubresourceName,
+		LocalAddress:          params.LocalAddress,
 		LocalPort:             params.LocalPort,
 		CLICommandProvider:    cliCommandProvider,
 		TCPPortAllocator:      s.cfg.TCPPortAllocator,
+		PromptMFA:             s.cfg.PromptMFA,
 	}
 
 	gateway, err := s.cfg.GatewayCreator.CreateGateway(ctx, clusterCreateGatewayParams)
@@ -180,6 +313,8 @@ func (s *Service) RemoveGateway(gatewayURI string) error {
 		return trace.Wrap(err)
 	}
 
+	s.persistGateways()
+
 	return nil
 }
 
@@ -228,6 +363,8 @@ func (s *Service) RestartGateway(ctx context.Context, gatewayURI string) error {
 	newGateway.SetURI(oldGateway.URI())
 	s.gateways[oldGateway.URI().String()] = newGateway
 
+	s.persistGateways()
+
 	return nil
 }
 
@@ -266,6 +403,8 @@ func (s *Service) SetGatewayTargetSubresourceName(gatewayURI, targetSubresourceN
 
 	gateway.SetTargetSubresourceName(targetSubresourceName)
 
+	s.persistGateways()
+
 	return gateway, nil
 }
 
@@ -313,6 +452,8 @@ func (s *Service) SetGatewayLocalPort(gatewayURI, localPort string) (*gateway.Ga
 
 	s.gateways[gatewayURI] = newGateway
 
+	s.persistGateways()
+
 	go func() {
 		if err := newGateway.Serve(); err != nil {
 			newGateway.Log().WithError(err).Warn("Failed to handle a gateway connection.")
@@ -324,47 +465,388 @@ func (s *Service) SetGatewayLocalPort(gatewayURI, localPort string) (*gateway.Ga
 
 // ListServers returns cluster servers
 func (s *Service) ListServers(ctx context.Context, clusterURI string) ([]clusters.Server, error) {
-	cluster, err := s.ResolveCluster(clusterURI)
-	if err != nil {
-		return nil, trace.Wrap(err)
+	if cached, ok := s.cache.get(cacheKey(clusterURI, "servers")); ok {
+		return cached.([]clusters.Server), nil
 	}
 
-	servers, err := cluster.GetServers(ctx)
+	var servers []clusters.Server
+	err := s.retryWithRelogin(ctx, clusterURI, func() error {
+		cluster, err := s.ResolveCluster(clusterURI)
+		if err != nil {
+			return trace.Wrap(err)
+		}
+
+		servers, err = cluster.GetServers(ctx)
+		return trace.Wrap(err)
+	})
 	if err != nil {
 		return nil, trace.Wrap(err)
 	}
 
+	s.cache.put(cacheKey(clusterURI, "servers"), servers)
+
 	return servers, nil
 }
 
 // ListServers returns cluster servers
 func (s *Service) ListApps(ctx context.Context, clusterURI string) ([]clusters.App, error) {
-	cluster, err := s.ResolveCluster(clusterURI)
-	if err != nil {
-		return nil, trace.Wrap(err)
+	if cached, ok := s.cache.get(cacheKey(clusterURI, "apps")); ok {
+		return cached.([]clusters.App), nil
 	}
 
-	apps, err := cluster.GetApps(ctx)
+	var apps []clusters.App
+	err := s.retryWithRelogin(ctx, clusterURI, func() error {
+		cluster, err := s.ResolveCluster(clusterURI)
+		if err != nil {
+			return trace.Wrap(err)
+		}
+
+		apps, err = cluster.GetApps(ctx)
+		return trace.Wrap(err)
+	})
 	if err != nil {
 		return nil, trace.Wrap(err)
 	}
 
+	s.cache.put(cacheKey(clusterURI, "apps"), apps)
+
 	return apps, nil
 }
 
 // ListKubes lists kubernetes clusters
 func (s *Service) ListKubes(ctx context.Context, uri string) ([]clusters.Kube, error) {
-	cluster, err := s.ResolveCluster(uri)
+	if cached, ok := s.cache.get(cacheKey(uri, "kubes")); ok {
+		return cached.([]clusters.Kube), nil
+	}
+
+	var kubes []clusters.Kube
+	err := s.retryWithRelogin(ctx, uri, func() error {
+		cluster, err := s.ResolveCluster(uri)
+		if err != nil {
+			return trace.Wrap(err)
+		}
+
+		kubes, err = cluster.GetKubes(ctx)
+		return trace.Wrap(err)
+	})
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	s.cache.put(cacheKey(uri, "kubes"), kubes)
+
+	return kubes, nil
+}
+
+// ListDatabases returns cluster databases
+func (s *Service) ListDatabases(ctx context.Context, clusterURI string) ([]clusters.Database, error) {
+	if cached, ok := s.cache.get(cacheKey(clusterURI, "databases")); ok {
+		return cached.([]clusters.Database), nil
+	}
+
+	var databases []clusters.Database
+	err := s.retryWithRelogin(ctx, clusterURI, func() error {
+		cluster, err := s.ResolveCluster(clusterURI)
+		if err != nil {
+			return trace.Wrap(err)
+		}
+
+		databases, err = cluster.GetDatabases(ctx)
+		return trace.Wrap(err)
+	})
 	if err != nil {
 		return nil, trace.Wrap(err)
 	}
 
-	kubes, err := cluster.GetKubes(ctx)
+	s.cache.put(cacheKey(clusterURI, "databases"), databases)
+
+	return databases, nil
+}
+
+// ListDatabasesWithFilters returns a single page of databases matching the filters. The
+// filtering, sorting and pagination happen server-side, so responses are not cached.
+func (s *Service) ListDatabasesWithFilters(ctx context.Context, clusterURI string, params clusters.GetDatabasesParams) (*clusters.GetDatabasesResponse, error) {
+	cluster, err := s.ResolveCluster(clusterURI)
 	if err != nil {
 		return nil, trace.Wrap(err)
 	}
 
-	return kubes, nil
+	response, err := cluster.GetDatabasesWithFilters(ctx, params)
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	return response, nil
+}
+
+// GetAllowedDatabaseUsers returns allowed users for the given database based on the role set
+func (s *Service) GetAllowedDatabaseUsers(ctx context.Context, dbURI string) (*clusters.DatabaseUsers, error) {
+	if cached, ok := s.cache.get(cacheKey(dbURI, "db-users")); ok {
+		return cached.(*clusters.DatabaseUsers), nil
+	}
+
+	var dbUsers *clusters.DatabaseUsers
+	err := s.retryWithRelogin(ctx, dbURI, func() error {
+		cluster, err := s.ResolveCluster(dbURI)
+		if err != nil {
+			return trace.Wrap(err)
+		}
+
+		dbUsers, err = cluster.GetAllowedDatabaseUsers(ctx, dbURI)
+		return trace.Wrap(err)
+	})
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	s.cache.put(cacheKey(dbURI, "db-users"), dbUsers)
+
+	return dbUsers, nil
+}
+
+// WatchResources subscribes to changes of the cluster resources. The caller consumes
+// watcher.Events() and closes the watcher when done.
+func (s *Service) WatchResources(ctx context.Context, clusterURI string) (types.Watcher, error) {
+	cluster, err := s.ResolveCluster(clusterURI)
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	watcher, err := cluster.WatchResources(ctx)
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	return watcher, nil
+}
+
+// GetAccessRequests returns the access requests of a cluster matching the filter
+func (s *Service) GetAccessRequests(ctx context.Context, clusterURI string, filter types.AccessRequestFilter) ([]types.AccessRequest, error) {
+	cluster, err := s.ResolveCluster(clusterURI)
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	requests, err := cluster.GetAccessRequests(ctx, filter)
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	return requests, nil
+}
+
+// CreateAccessRequest creates an access request for the logged-in user
+func (s *Service) CreateAccessRequest(ctx context.Context, clusterURI string, params clusters.CreateAccessRequestParams) (types.AccessRequest, error) {
+	cluster, err := s.ResolveCluster(clusterURI)
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	request, err := cluster.CreateAccessRequest(ctx, params)
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	return request, nil
+}
+
+// ReviewAccessRequest submits a review of an access request
+func (s *Service) ReviewAccessRequest(ctx context.Context, clusterURI string, params clusters.ReviewAccessRequestParams) (types.AccessRequest, error) {
+	cluster, err := s.ResolveCluster(clusterURI)
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	request, err := cluster.ReviewAccessRequest(ctx, params)
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	return request, nil
+}
+
+// DeleteAccessRequest removes an access request
+func (s *Service) DeleteAccessRequest(ctx context.Context, clusterURI, requestID string) error {
+	cluster, err := s.ResolveCluster(clusterURI)
+	if err != nil {
+		return trace.Wrap(err)
+	}
+
+	return trace.Wrap(cluster.DeleteAccessRequest(ctx, requestID))
+}
+
+// GetAccessCapabilities returns the requestable roles and suggested reviewers of the logged-in user
+func (s *Service) GetAccessCapabilities(ctx context.Context, clusterURI string) (*types.AccessCapabilities, error) {
+	cluster, err := s.ResolveCluster(clusterURI)
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	caps, err := cluster.GetAccessCapabilities(ctx)
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	return caps, nil
+}
+
+// AssumeRoles reissues the user certs with the given approved access requests added and the given
+// requests dropped
+func (s *Service) AssumeRoles(ctx context.Context, clusterURI string, requestIDs, dropRequestIDs []string) error {
+	cluster, err := s.ResolveCluster(clusterURI)
+	if err != nil {
+		return trace.Wrap(err)
+	}
+
+	return trace.Wrap(cluster.AssumeRoles(ctx, requestIDs, dropRequestIDs))
+}
+
+// CreateConnectMyComputerRole creates a role giving the logged-in user access to their own
+// Connect My Computer node and assigns the role to the user
+func (s *Service) CreateConnectMyComputerRole(ctx context.Context, clusterURI string) (types.Role, error) {
+	cluster, err := s.ResolveCluster(clusterURI)
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	role, err := cluster.CreateConnectMyComputerRole(ctx)
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	return role, nil
+}
+
+// CreateConnectMyComputerNodeToken creates a short-lived provision token the Connect My Computer
+// node agent uses to join the cluster
+func (s *Service) CreateConnectMyComputerNodeToken(ctx context.Context, clusterURI string) (string, error) {
+	cluster, err := s.ResolveCluster(clusterURI)
+	if err != nil {
+		return "", trace.Wrap(err)
+	}
+
+	token, err := cluster.CreateConnectMyComputerNodeToken(ctx)
+	if err != nil {
+		return "", trace.Wrap(err)
+	}
+
+	return token, nil
+}
+
+// WaitForConnectMyComputerNodeJoin blocks until a node owned by the logged-in user joins the
+// cluster, returning that node
+func (s *Service) WaitForConnectMyComputerNodeJoin(ctx context.Context, clusterURI string) (*clusters.Server, error) {
+	cluster, err := s.ResolveCluster(clusterURI)
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	server, err := cluster.WaitForConnectMyComputerNodeJoin(ctx)
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	return server, nil
+}
+
+// ListDesktops returns cluster desktops
+func (s *Service) ListDesktops(ctx context.Context, clusterURI string) ([]clusters.Desktop, error) {
+	cluster, err := s.ResolveCluster(clusterURI)
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	desktops, err := cluster.GetDesktops(ctx)
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	return desktops, nil
+}
+
+// GetDesktopConnectParams returns the parameters the app uses to open a session to a desktop
+func (s *Service) GetDesktopConnectParams(ctx context.Context, desktopURI string) (*clusters.DesktopConnectParams, error) {
+	cluster, err := s.ResolveCluster(desktopURI)
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	params, err := cluster.GetDesktopConnectParams(ctx, desktopURI)
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	return params, nil
+}
+
+// GetRecordings returns a page of recorded sessions of a cluster along with the key of the next
+// page
+func (s *Service) GetRecordings(ctx context.Context, clusterURI string, params clusters.GetRecordingsParams) ([]clusters.Recording, string, error) {
+	cluster, err := s.ResolveCluster(clusterURI)
+	if err != nil {
+		return nil, "", trace.Wrap(err)
+	}
+
+	recordings, nextKey, err := cluster.GetRecordings(ctx, params)
+	if err != nil {
+		return nil, "", trace.Wrap(err)
+	}
+
+	return recordings, nextKey, nil
+}
+
+// StreamRecording streams the events of a recorded session starting at the given event index
+func (s *Service) StreamRecording(ctx context.Context, clusterURI, sessionID string, startIndex int64) (chan apievents.AuditEvent, chan error, error) {
+	cluster, err := s.ResolveCluster(clusterURI)
+	if err != nil {
+		return nil, nil, trace.Wrap(err)
+	}
+
+	eventsC, errC, err := cluster.StreamRecording(ctx, sessionID, startIndex)
+	if err != nil {
+		return nil, nil, trace.Wrap(err)
+	}
+
+	return eventsC, errC, nil
+}
+
+// ConnectToServer opens an interactive shell on the server the params point at
+func (s *Service) ConnectToServer(ctx context.Context, params clusters.SSHSessionParams) (*clusters.SSHSession, error) {
+	var cluster *clusters.Cluster
+	var session *clusters.SSHSession
+	err := s.retryWithRelogin(ctx, params.ServerURI, func() error {
+		var err error
+		cluster, err = s.ResolveCluster(params.ServerURI)
+		if err != nil {
+			return trace.Wrap(err)
+		}
+
+		session, err = cluster.ConnectToServer(ctx, params)
+		return trace.Wrap(err)
+	})
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	s.mu.Lock()
+	s.recordRecentConnectionLocked(params.ServerURI, map[string]string{"login": params.Login})
+	s.mu.Unlock()
+
+	s.reportUsageEvent(cluster.URI.String(), "connect.server.connect", map[string]string{
+		"server_uri": params.ServerURI,
+	})
+
+	return session, nil
+}
+
+// TransferFile copies a single file between the local machine and a cluster server
+func (s *Service) TransferFile(ctx context.Context, params clusters.FileTransferParams) error {
+	cluster, err := s.ResolveCluster(params.ServerURI)
+	if err != nil {
+		return trace.Wrap(err)
+	}
+
+	return trace.Wrap(cluster.TransferFile(ctx, params))
 }
 
 // Stop terminates all cluster open connections
@@ -375,6 +857,10 @@ func (s *Service) Stop() {
 	for _, gateway := range s.gateways {
 		gateway.Close()
 	}
+
+	if s.usageReporter != nil {
+		s.usageReporter.Stop()
+	}
 }
 
 // Service is the daemon service
@@ -384,11 +870,22 @@ type Service struct {
 	// gateways holds the long-running gateways for resources on different clusters. So far it's been
 	// used mostly for database gateways but it has potential to be used for app access as well.
 	gateways map[string]*gateway.Gateway
+	// appRoutes maps public hostnames of TCP apps to their allocated loopback addresses, see
+	// ResolveApp.
+	appRoutes map[string]*appRoute
+	// appAddrOffset is the number of loopback addresses handed out to TCP apps so far.
+	appAddrOffset uint32
+	// usageReporter batches usage events for submission, nil when usage reporting is off.
+	usageReporter *usageReporter
+	// cache holds short-lived responses of expensive cluster calls, see responseCache.
+	cache *responseCache
 }
 
 type CreateGatewayParams struct {
 	TargetURI             string
 	TargetUser            string
 	TargetSubresourceName string
-	LocalPort             string
+	// LocalAddress is the address the gateway listens on, defaults to localhost.
+	LocalAddress string
+	LocalPort    string
 }
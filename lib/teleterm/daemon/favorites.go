@@ -0,0 +1,145 @@
+// Copyright 2022 Gravitational, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package daemon
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	apiutils "github.com/gravitational/teleport/api/utils"
+	"github.com/gravitational/teleport/lib/teleterm/api/uri"
+
+	"github.com/gravitational/trace"
+)
+
+// favoritesFile is the name of the file under the profile dir holding the URIs of the pinned
+// resources.
+const favoritesFile = "favorites.json"
+
+func (s *Service) favoritesPath() string {
+	return filepath.Join(s.cfg.Storage.Dir, favoritesFile)
+}
+
+// AddFavorite pins the resource the URI points at and returns all pinned resources.
+func (s *Service) AddFavorite(resourceURI string) ([]string, error) {
+	if uri.New(resourceURI).GetProfileName() == "" {
+		return nil, trace.BadParameter("invalid resource URI: %v", resourceURI)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	favorites, err := s.loadFavorites()
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	if apiutils.SliceContainsStr(favorites, resourceURI) {
+		return favorites, nil
+	}
+
+	favorites = append(favorites, resourceURI)
+	sort.Strings(favorites)
+
+	if err := s.saveFavorites(favorites); err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	return favorites, nil
+}
+
+// RemoveFavorite unpins the resource the URI points at and returns all pinned resources.
+func (s *Service) RemoveFavorite(resourceURI string) ([]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	favorites, err := s.loadFavorites()
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	remaining := favorites[:0]
+	for _, favorite := range favorites {
+		if favorite != resourceURI {
+			remaining = append(remaining, favorite)
+		}
+	}
+
+	if len(remaining) == len(favorites) {
+		return favorites, nil
+	}
+
+	if err := s.saveFavorites(remaining); err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	return remaining, nil
+}
+
+// ListFavorites returns the pinned resources of a cluster, or of all clusters when clusterURI is
+// empty.
+func (s *Service) ListFavorites(clusterURI string) ([]string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	favorites, err := s.loadFavorites()
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	if clusterURI == "" {
+		return favorites, nil
+	}
+
+	filtered := []string{}
+	for _, favorite := range favorites {
+		if strings.HasPrefix(favorite, clusterURI+"/") {
+			filtered = append(filtered, favorite)
+		}
+	}
+
+	return filtered, nil
+}
+
+// loadFavorites reads the pinned resource URIs from disk. Requires s.mu to be held.
+func (s *Service) loadFavorites() ([]string, error) {
+	data, err := os.ReadFile(s.favoritesPath())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []string{}, nil
+		}
+		return nil, trace.ConvertSystemError(err)
+	}
+
+	var favorites []string
+	if err := json.Unmarshal(data, &favorites); err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	return favorites, nil
+}
+
+// saveFavorites writes the pinned resource URIs to disk. Requires s.mu to be held.
+func (s *Service) saveFavorites(favorites []string) error {
+	data, err := json.MarshalIndent(favorites, "", "  ")
+	if err != nil {
+		return trace.Wrap(err)
+	}
+
+	return trace.ConvertSystemError(os.WriteFile(s.favoritesPath(), data, 0600))
+}
@@ -0,0 +1,59 @@
+// Copyright 2022 Gravitational, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package daemon
+
+import (
+	"testing"
+
+	"github.com/gravitational/teleport/lib/teleterm/clusters"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFavorites(t *testing.T) {
+	storage, err := clusters.NewStorage(clusters.Config{
+		Dir:                t.TempDir(),
+		InsecureSkipVerify: true,
+	})
+	require.NoError(t, err)
+
+	daemon, err := New(Config{Storage: storage})
+	require.NoError(t, err)
+
+	favorites, err := daemon.ListFavorites("")
+	require.NoError(t, err)
+	require.Empty(t, favorites)
+
+	_, err = daemon.AddFavorite("/clusters/foo/servers/one")
+	require.NoError(t, err)
+	_, err = daemon.AddFavorite("/clusters/bar/dbs/two")
+	require.NoError(t, err)
+
+	// Pinning an already pinned resource is a noop.
+	favorites, err = daemon.AddFavorite("/clusters/foo/servers/one")
+	require.NoError(t, err)
+	require.Len(t, favorites, 2)
+
+	favorites, err = daemon.ListFavorites("/clusters/foo")
+	require.NoError(t, err)
+	require.Equal(t, []string{"/clusters/foo/servers/one"}, favorites)
+
+	favorites, err = daemon.RemoveFavorite("/clusters/foo/servers/one")
+	require.NoError(t, err)
+	require.Equal(t, []string{"/clusters/bar/dbs/two"}, favorites)
+
+	_, err = daemon.AddFavorite("bogus")
+	require.Error(t, err)
+}
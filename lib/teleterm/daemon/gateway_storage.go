@@ -0,0 +1,109 @@
+// Copyright 2022 Gravitational, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package daemon
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/gravitational/trace"
+)
+
+// gatewayStateFile is the name of the file under the profile dir holding the state of the open
+// gateways, so they can be recreated after a daemon restart.
+const gatewayStateFile = "gateways.json"
+
+// gatewayState is the persisted state of a single gateway, just enough to recreate it on the
+// same local port.
+type gatewayState struct {
+	TargetURI             string `json:"target_uri"`
+	TargetUser            string `json:"target_user,omitempty"`
+	TargetSubresourceName string `json:"target_subresource_name,omitempty"`
+	LocalPort             string `json:"local_port,omitempty"`
+}
+
+func (s *Service) gatewayStatePath() string {
+	return filepath.Join(s.cfg.Storage.Dir, gatewayStateFile)
+}
+
+// persistGateways writes the state of the open gateways to disk. Failures are logged rather than
+// returned – persistence is best-effort and shouldn't fail gateway operations. Requires s.mu to
+// be held.
+func (s *Service) persistGateways() {
+	states := make([]gatewayState, 0, len(s.gateways))
+	for _, gateway := range s.gateways {
+		states = append(states, gatewayState{
+			TargetURI:             gateway.TargetURI(),
+			TargetUser:            gateway.TargetUser(),
+			TargetSubresourceName: gateway.TargetSubresourceName(),
+			LocalPort:             gateway.LocalPort(),
+		})
+	}
+	sort.Slice(states, func(i, j int) bool { return states[i].TargetURI < states[j].TargetURI })
+
+	data, err := json.MarshalIndent(states, "", "  ")
+	if err != nil {
+		s.cfg.Log.WithError(err).Warn("Failed to marshal the gateway state.")
+		return
+	}
+
+	if err := os.WriteFile(s.gatewayStatePath(), data, 0600); err != nil {
+		s.cfg.Log.WithError(err).Warn("Failed to persist the gateway state.")
+	}
+}
+
+// RestoreGateways recreates the gateways persisted by the previous daemon run on their original
+// local ports. Gateways whose targets cannot be reached are skipped, they will be reported as
+// closed to the UI.
+func (s *Service) RestoreGateways(ctx context.Context) error {
+	data, err := os.ReadFile(s.gatewayStatePath())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return trace.ConvertSystemError(err)
+	}
+
+	var states []gatewayState
+	if err := json.Unmarshal(data, &states); err != nil {
+		return trace.Wrap(err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, state := range states {
+		// Go through the internal createGateway rather than CreateGateway – restoring a gateway
+		// recreates what the user already had, so it shouldn't record a recent connection or emit
+		// a usage event again.
+		_, err := s.createGateway(ctx, CreateGatewayParams{
+			TargetURI:             state.TargetURI,
+			TargetUser:            state.TargetUser,
+			TargetSubresourceName: state.TargetSubresourceName,
+			LocalPort:             state.LocalPort,
+		})
+		if err != nil {
+			s.cfg.Log.WithError(err).Warnf("Failed to restore the gateway for %v.", state.TargetURI)
+		}
+	}
+
+	// Rewrite the state so gateways which could not be restored are dropped from it.
+	s.persistGateways()
+
+	return nil
+}
@@ -0,0 +1,177 @@
+// Copyright 2022 Gravitational, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package daemon
+
+import (
+	"context"
+	"time"
+
+	"github.com/gravitational/teleport/api/types"
+
+	"github.com/gravitational/trace"
+)
+
+// certExpiryWarningBuffer is how long before the cert expiry the warning notification is sent.
+const certExpiryWarningBuffer = 5 * time.Minute
+
+// certExpiryCheckInterval is how often the cert expiry is checked.
+const certExpiryCheckInterval = time.Minute
+
+// Notification is a daemon-originated event the app shows to the user as a toast, sent over the
+// tshd events service. Exactly one of the subject fields is set.
+type Notification struct {
+	// ClusterURI is the URI of the cluster the notification concerns.
+	ClusterURI string
+	// AccessRequestUpdate is set when an access request concerning the user changed state.
+	AccessRequestUpdate *AccessRequestUpdate
+	// CertExpiry is set when the user certs are about to expire.
+	CertExpiry *CertExpiry
+}
+
+// AccessRequestUpdate describes a state change of an access request.
+type AccessRequestUpdate struct {
+	// RequestID is the ID of the access request.
+	RequestID string
+	// State is the new state of the request, e.g. "APPROVED".
+	State string
+}
+
+// CertExpiry warns that the user certs expire soon.
+type CertExpiry struct {
+	// NotAfter is when the certs expire.
+	NotAfter time.Time
+	// AssumedRoles is true when the certs carry roles assumed from access requests, so the
+	// expiry also means dropping the assumed roles.
+	AssumedRoles bool
+}
+
+// SendNotificationFunc delivers a notification to the Electron app over the tshd events service.
+type SendNotificationFunc func(notification Notification)
+
+// StartClusterNotifications starts the background watchers emitting notifications for the given
+// cluster: access request state changes and imminent cert expiry. The watchers run until ctx is
+// canceled. Noop when no notification sender is configured.
+func (s *Service) StartClusterNotifications(ctx context.Context, clusterURI string) error {
+	if s.cfg.SendNotification == nil {
+		return nil
+	}
+
+	cluster, err := s.ResolveCluster(clusterURI)
+	if err != nil {
+		return trace.Wrap(err)
+	}
+
+	if !cluster.Connected() {
+		return trace.BadParameter("cluster %v is not connected", clusterURI)
+	}
+
+	go s.watchAccessRequestNotifications(ctx, clusterURI)
+	go s.watchCertExpiryNotifications(ctx, clusterURI)
+
+	return nil
+}
+
+// watchAccessRequestNotifications emits a notification for every state change of an access
+// request concerning the user.
+func (s *Service) watchAccessRequestNotifications(ctx context.Context, clusterURI string) {
+	cluster, err := s.ResolveCluster(clusterURI)
+	if err != nil {
+		s.cfg.Log.WithError(err).Warnf("Failed to resolve cluster %v for access request notifications.", clusterURI)
+		return
+	}
+
+	watcher, err := cluster.WatchAccessRequests(ctx)
+	if err != nil {
+		s.cfg.Log.WithError(err).Warnf("Failed to watch access requests in cluster %v.", clusterURI)
+		return
+	}
+	defer watcher.Close()
+
+	for {
+		select {
+		case event := <-watcher.Events():
+			if event.Type != types.OpPut {
+				continue
+			}
+			request, ok := event.Resource.(types.AccessRequest)
+			if !ok {
+				continue
+			}
+			// The initial PENDING put is the request being created, every later put is a state
+			// change worth telling the user about.
+			if request.GetState().IsPending() {
+				continue
+			}
+
+			s.cfg.SendNotification(Notification{
+				ClusterURI: clusterURI,
+				AccessRequestUpdate: &AccessRequestUpdate{
+					RequestID: request.GetName(),
+					State:     request.GetState().String(),
+				},
+			})
+		case <-watcher.Done():
+			if err := watcher.Error(); err != nil && ctx.Err() == nil {
+				s.cfg.Log.WithError(err).Warnf("Access request watcher for cluster %v closed.", clusterURI)
+			}
+			return
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// watchCertExpiryNotifications warns the user once when the cluster certs are about to expire,
+// so an expired session doesn't come as a surprise on the next action.
+func (s *Service) watchCertExpiryNotifications(ctx context.Context, clusterURI string) {
+	ticker := time.NewTicker(certExpiryCheckInterval)
+	defer ticker.Stop()
+
+	warned := false
+	for {
+		select {
+		case <-ticker.C:
+			// Re-resolve the cluster every tick so a relogin with fresh certs resets the warning.
+			cluster, err := s.ResolveCluster(clusterURI)
+			if err != nil {
+				return
+			}
+
+			expiry := cluster.GetCertExpiry()
+			if expiry.IsZero() {
+				continue
+			}
+
+			if time.Until(expiry) > certExpiryWarningBuffer {
+				warned = false
+				continue
+			}
+			if warned {
+				continue
+			}
+
+			s.cfg.SendNotification(Notification{
+				ClusterURI: clusterURI,
+				CertExpiry: &CertExpiry{
+					NotAfter:     expiry,
+					AssumedRoles: cluster.HasAssumedRoles(),
+				},
+			})
+			warned = true
+		case <-ctx.Done():
+			return
+		}
+	}
+}
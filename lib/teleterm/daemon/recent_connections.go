@@ -0,0 +1,128 @@
+// Copyright 2022 Gravitational, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package daemon
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/gravitational/trace"
+)
+
+// recentConnectionsFile is the name of the file under the profile dir holding the recent
+// connections, so the home screen can surface them across restarts.
+const recentConnectionsFile = "recent_connections.json"
+
+// maxRecentConnections caps how many recent connections are kept.
+const maxRecentConnections = 20
+
+// RecentConnection describes a successful connection to a resource.
+type RecentConnection struct {
+	// ResourceURI is the URI of the resource the connection was made to.
+	ResourceURI string `json:"resource_uri"`
+	// Params are extra connection parameters, e.g. the database user for db connections.
+	Params map[string]string `json:"params,omitempty"`
+	// ConnectedAt is when the connection was established.
+	ConnectedAt time.Time `json:"connected_at"`
+}
+
+func (s *Service) recentConnectionsPath() string {
+	return filepath.Join(s.cfg.Storage.Dir, recentConnectionsFile)
+}
+
+// recordRecentConnectionLocked prepends a connection to the recent connections list, dropping
+// any previous entry for the same resource. Failures are logged rather than returned – tracking
+// is best-effort and shouldn't fail the connection. Requires s.mu to be held.
+func (s *Service) recordRecentConnectionLocked(resourceURI string, params map[string]string) {
+	connections, err := s.loadRecentConnections()
+	if err != nil {
+		s.cfg.Log.WithError(err).Warn("Failed to read the recent connections.")
+		return
+	}
+
+	updated := []RecentConnection{{
+		ResourceURI: resourceURI,
+		Params:      params,
+		ConnectedAt: time.Now().UTC(),
+	}}
+	for _, connection := range connections {
+		if connection.ResourceURI == resourceURI {
+			continue
+		}
+		updated = append(updated, connection)
+	}
+	if len(updated) > maxRecentConnections {
+		updated = updated[:maxRecentConnections]
+	}
+
+	if err := s.saveRecentConnections(updated); err != nil {
+		s.cfg.Log.WithError(err).Warn("Failed to persist the recent connections.")
+	}
+}
+
+// ListRecentConnections returns the recent connections, most recent first.
+func (s *Service) ListRecentConnections() ([]RecentConnection, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	connections, err := s.loadRecentConnections()
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	return connections, nil
+}
+
+// ClearRecentConnections removes all recent connections.
+func (s *Service) ClearRecentConnections() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := os.Remove(s.recentConnectionsPath()); err != nil && !os.IsNotExist(err) {
+		return trace.ConvertSystemError(err)
+	}
+
+	return nil
+}
+
+// saveRecentConnections writes the recent connections to disk. Requires s.mu to be held.
+func (s *Service) saveRecentConnections(connections []RecentConnection) error {
+	data, err := json.MarshalIndent(connections, "", "  ")
+	if err != nil {
+		return trace.Wrap(err)
+	}
+
+	return trace.ConvertSystemError(os.WriteFile(s.recentConnectionsPath(), data, 0600))
+}
+
+// loadRecentConnections reads the recent connections from disk. Requires s.mu to be held.
+func (s *Service) loadRecentConnections() ([]RecentConnection, error) {
+	data, err := os.ReadFile(s.recentConnectionsPath())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []RecentConnection{}, nil
+		}
+		return nil, trace.ConvertSystemError(err)
+	}
+
+	var connections []RecentConnection
+	if err := json.Unmarshal(data, &connections); err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	return connections, nil
+}
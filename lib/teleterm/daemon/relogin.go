@@ -0,0 +1,53 @@
+// Copyright 2022 Gravitational, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package daemon
+
+import (
+	"context"
+
+	"github.com/gravitational/teleport/lib/client"
+	"github.com/gravitational/teleport/lib/teleterm/api/uri"
+
+	"github.com/gravitational/trace"
+)
+
+// ReloginFunc asks the Electron app to log the user into the given root cluster. The
+// implementation sends the Relogin tshd event and blocks until the user completes or dismisses
+// the login flow.
+type ReloginFunc func(ctx context.Context, rootClusterURI string) error
+
+// retryWithRelogin runs fn and, when it fails because the user certs expired or are missing,
+// asks the app to open the login flow and runs fn once more after a successful login. This way
+// an expired session mid-operation results in a login prompt and a transparent retry instead of
+// a raw error. fn must resolve the cluster itself so the retry picks up the fresh certs.
+func (s *Service) retryWithRelogin(ctx context.Context, resourceURI string, fn func() error) error {
+	err := fn()
+	if err == nil {
+		return nil
+	}
+
+	if s.cfg.Relogin == nil || !client.IsErrorResolvableWithRelogin(err) {
+		return trace.Wrap(err)
+	}
+
+	rootClusterURI := uri.NewClusterURI(uri.New(resourceURI).GetProfileName()).String()
+	if reloginErr := s.cfg.Relogin(ctx, rootClusterURI); reloginErr != nil {
+		s.cfg.Log.WithError(reloginErr).Warnf("Relogin to %v failed.", rootClusterURI)
+		// Surface the original error – the relogin attempt is an implementation detail.
+		return trace.Wrap(err)
+	}
+
+	return trace.Wrap(fn())
+}
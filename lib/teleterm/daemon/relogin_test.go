@@ -0,0 +1,89 @@
+// Copyright 2022 Gravitational, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package daemon
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gravitational/teleport/lib/teleterm/clusters"
+
+	"github.com/gravitational/trace"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestDaemon(t *testing.T, relogin ReloginFunc) *Service {
+	storage, err := clusters.NewStorage(clusters.Config{
+		Dir:                t.TempDir(),
+		InsecureSkipVerify: true,
+	})
+	require.NoError(t, err)
+
+	daemon, err := New(Config{Storage: storage, Relogin: relogin})
+	require.NoError(t, err)
+
+	return daemon
+}
+
+func TestRetryWithReloginRetriesAfterLogin(t *testing.T) {
+	var reloginCalls int
+	daemon := newTestDaemon(t, func(ctx context.Context, rootClusterURI string) error {
+		reloginCalls++
+		require.Equal(t, "/clusters/foo", rootClusterURI)
+		return nil
+	})
+
+	calls := 0
+	err := daemon.retryWithRelogin(context.Background(), "/clusters/foo/servers/one", func() error {
+		calls++
+		if calls == 1 {
+			// BadParameter is one of the errors treated as resolvable with relogin.
+			return trace.BadParameter("ssh: cert has expired")
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, calls)
+	require.Equal(t, 1, reloginCalls)
+}
+
+func TestRetryWithReloginSurfacesOriginalErrorWhenLoginFails(t *testing.T) {
+	daemon := newTestDaemon(t, func(ctx context.Context, rootClusterURI string) error {
+		return trace.BadParameter("user dismissed the login flow")
+	})
+
+	calls := 0
+	err := daemon.retryWithRelogin(context.Background(), "/clusters/foo/servers/one", func() error {
+		calls++
+		return trace.BadParameter("ssh: cert has expired")
+	})
+	require.ErrorContains(t, err, "cert has expired")
+	require.Equal(t, 1, calls)
+}
+
+func TestRetryWithReloginSkipsUnresolvableErrors(t *testing.T) {
+	daemon := newTestDaemon(t, func(ctx context.Context, rootClusterURI string) error {
+		t.Fatal("relogin must not be called for errors not resolvable with relogin")
+		return nil
+	})
+
+	calls := 0
+	err := daemon.retryWithRelogin(context.Background(), "/clusters/foo/servers/one", func() error {
+		calls++
+		return trace.NotFound("server is not found")
+	})
+	require.Error(t, err)
+	require.Equal(t, 1, calls)
+}
@@ -0,0 +1,120 @@
+// Copyright 2022 Gravitational, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package daemon
+
+import (
+	"context"
+	"sync"
+
+	"github.com/gravitational/teleport/lib/teleterm/clusters"
+
+	"github.com/gravitational/trace"
+)
+
+// maxConcurrentClusterFetches bounds how many clusters are queried at the same time when
+// resources are fetched for several clusters at once.
+const maxConcurrentClusterFetches = 4
+
+// ClusterResources holds the resources of a single cluster fetched by FetchClusterResources.
+type ClusterResources struct {
+	// ClusterURI is the URI of the cluster the resources belong to.
+	ClusterURI string
+	// Servers are the cluster servers.
+	Servers []clusters.Server
+	// Databases are the cluster databases.
+	Databases []clusters.Database
+	// Kubes are the cluster kube clusters.
+	Kubes []clusters.Kube
+	// Apps are the cluster apps.
+	Apps []clusters.App
+	// Err is set when some of the resources could not be fetched. The other fields still hold
+	// whatever was fetched successfully.
+	Err error
+}
+
+// FetchClusterResources fetches the resources of the given clusters through a bounded worker
+// pool and returns one result per cluster, in the order of the passed URIs. A cluster that cannot
+// be reached produces a result with Err set instead of failing the whole call, so the UI can
+// render the clusters that did respond.
+func (s *Service) FetchClusterResources(ctx context.Context, clusterURIs []string) []ClusterResources {
+	results := make([]ClusterResources, len(clusterURIs))
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+
+	workerCount := maxConcurrentClusterFetches
+	if len(clusterURIs) < workerCount {
+		workerCount = len(clusterURIs)
+	}
+
+	for i := 0; i < workerCount; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for job := range jobs {
+				results[job] = s.fetchSingleClusterResources(ctx, clusterURIs[job])
+			}
+		}()
+	}
+
+	for i := range clusterURIs {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	return results
+}
+
+// fetchSingleClusterResources fetches all resource kinds of one cluster, collecting per-kind
+// errors instead of bailing out on the first one.
+func (s *Service) fetchSingleClusterResources(ctx context.Context, clusterURI string) ClusterResources {
+	result := ClusterResources{ClusterURI: clusterURI}
+
+	var errors []error
+
+	servers, err := s.ListServers(ctx, clusterURI)
+	if err != nil {
+		errors = append(errors, trace.Wrap(err))
+	} else {
+		result.Servers = servers
+	}
+
+	databases, err := s.ListDatabases(ctx, clusterURI)
+	if err != nil {
+		errors = append(errors, trace.Wrap(err))
+	} else {
+		result.Databases = databases
+	}
+
+	kubes, err := s.ListKubes(ctx, clusterURI)
+	if err != nil {
+		errors = append(errors, trace.Wrap(err))
+	} else {
+		result.Kubes = kubes
+	}
+
+	apps, err := s.ListApps(ctx, clusterURI)
+	if err != nil {
+		errors = append(errors, trace.Wrap(err))
+	} else {
+		result.Apps = apps
+	}
+
+	result.Err = trace.NewAggregate(errors...)
+
+	return result
+}
@@ -0,0 +1,51 @@
+// Copyright 2022 Gravitational, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package daemon
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/gravitational/teleport/lib/teleterm/clusters"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFetchClusterResourcesReturnsPerClusterErrors(t *testing.T) {
+	storage, err := clusters.NewStorage(clusters.Config{
+		Dir:                t.TempDir(),
+		InsecureSkipVerify: true,
+	})
+	require.NoError(t, err)
+
+	daemon, err := New(Config{Storage: storage})
+	require.NoError(t, err)
+
+	// More clusters than workers, none of them with a profile on disk, so every fetch fails with
+	// its own error rather than failing the whole call.
+	var clusterURIs []string
+	for i := 0; i < maxConcurrentClusterFetches+2; i++ {
+		clusterURIs = append(clusterURIs, fmt.Sprintf("/clusters/cluster-%v", i))
+	}
+
+	results := daemon.FetchClusterResources(context.Background(), clusterURIs)
+	require.Len(t, results, len(clusterURIs))
+
+	for i, result := range results {
+		require.Equal(t, clusterURIs[i], result.ClusterURI)
+		require.Error(t, result.Err)
+	}
+}
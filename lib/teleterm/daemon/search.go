@@ -0,0 +1,226 @@
+// Copyright 2022 Gravitational, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package daemon
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/gravitational/teleport/api/types"
+	"github.com/gravitational/teleport/lib/teleterm/clusters"
+
+	"github.com/gravitational/trace"
+)
+
+// SearchResourcesParams describe the search phrase and the limits of a resource search.
+type SearchResourcesParams struct {
+	// Search is the fuzzy-matched search phrase.
+	Search string
+	// Limit caps the number of returned results, zero means no limit.
+	Limit int
+}
+
+// SearchResult is a single resource matched by SearchResources.
+type SearchResult struct {
+	// ClusterURI is the URI of the cluster the resource belongs to.
+	ClusterURI string
+	// ResourceURI is the URI of the matched resource.
+	ResourceURI string
+	// Kind is the resource kind, one of types.KindNode, types.KindDatabase,
+	// types.KindKubernetesCluster and types.KindApp.
+	Kind string
+	// Name is the resource name shown to the user. For servers it is the hostname.
+	Name string
+	// Labels are all the resource labels.
+	Labels map[string]string
+	// Score ranks the match, higher is better.
+	Score int
+}
+
+// SearchResources fuzzy-matches resources from all logged-in clusters against the search phrase
+// and returns the results ranked by how well they match, best matches first. Clusters that cannot
+// be reached are skipped so a single unreachable cluster doesn't break the global search.
+func (s *Service) SearchResources(ctx context.Context, params SearchResourcesParams) ([]SearchResult, error) {
+	cs, err := s.cfg.Storage.ReadAll()
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	var (
+		mu      sync.Mutex
+		wg      sync.WaitGroup
+		results []SearchResult
+	)
+	for _, cluster := range cs {
+		if !cluster.Connected() {
+			continue
+		}
+
+		cluster := cluster
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			clusterResults, err := searchCluster(ctx, cluster, params.Search)
+			if err != nil {
+				s.cfg.Log.WithError(err).Warnf("Failed to search resources in cluster %v.", cluster.URI)
+				return
+			}
+
+			mu.Lock()
+			results = append(results, clusterResults...)
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	sort.SliceStable(results, func(i, j int) bool {
+		if results[i].Score != results[j].Score {
+			return results[i].Score > results[j].Score
+		}
+		return results[i].Name < results[j].Name
+	})
+
+	if params.Limit > 0 && len(results) > params.Limit {
+		results = results[:params.Limit]
+	}
+
+	return results, nil
+}
+
+// searchCluster matches all resources of a single cluster against the search phrase.
+func searchCluster(ctx context.Context, cluster *clusters.Cluster, search string) ([]SearchResult, error) {
+	var results []SearchResult
+
+	servers, err := cluster.GetServers(ctx)
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+	for _, server := range servers {
+		if score := scoreMatch(search, server.GetHostname(), server.GetAllLabels()); score > 0 {
+			results = append(results, SearchResult{
+				ClusterURI:  cluster.URI.String(),
+				ResourceURI: server.URI.String(),
+				Kind:        types.KindNode,
+				Name:        server.GetHostname(),
+				Labels:      server.GetAllLabels(),
+				Score:       score,
+			})
+		}
+	}
+
+	dbs, err := cluster.GetDatabases(ctx)
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+	for _, db := range dbs {
+		if score := scoreMatch(search, db.GetName(), db.GetAllLabels()); score > 0 {
+			results = append(results, SearchResult{
+				ClusterURI:  cluster.URI.String(),
+				ResourceURI: db.URI.String(),
+				Kind:        types.KindDatabase,
+				Name:        db.GetName(),
+				Labels:      db.GetAllLabels(),
+				Score:       score,
+			})
+		}
+	}
+
+	kubes, err := cluster.GetKubes(ctx)
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+	for _, kube := range kubes {
+		name := kube.KubernetesCluster.GetName()
+		labels := kube.KubernetesCluster.GetAllLabels()
+		if score := scoreMatch(search, name, labels); score > 0 {
+			results = append(results, SearchResult{
+				ClusterURI:  cluster.URI.String(),
+				ResourceURI: kube.URI.String(),
+				Kind:        types.KindKubernetesCluster,
+				Name:        name,
+				Labels:      labels,
+				Score:       score,
+			})
+		}
+	}
+
+	apps, err := cluster.GetApps(ctx)
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+	for _, app := range apps {
+		if score := scoreMatch(search, app.GetName(), app.GetAllLabels()); score > 0 {
+			results = append(results, SearchResult{
+				ClusterURI:  cluster.URI.String(),
+				ResourceURI: app.URI.String(),
+				Kind:        types.KindApp,
+				Name:        app.GetName(),
+				Labels:      app.GetAllLabels(),
+				Score:       score,
+			})
+		}
+	}
+
+	return results, nil
+}
+
+// Scores of a single search term, from the best kind of match to the worst.
+const (
+	scoreNameExact     = 300
+	scoreNamePrefix    = 200
+	scoreNameSubstring = 100
+	scoreLabelMatch    = 50
+)
+
+// scoreMatch ranks how well a resource matches the search phrase. The phrase is split into terms
+// and every term must match the resource name or one of the labels, otherwise the score is zero.
+// Name matches rank above label matches so resources matched by name come first.
+func scoreMatch(search, name string, labels map[string]string) int {
+	terms := strings.Fields(strings.ToLower(search))
+	if len(terms) == 0 {
+		return 0
+	}
+
+	lowerName := strings.ToLower(name)
+	total := 0
+	for _, term := range terms {
+		score := 0
+		switch {
+		case lowerName == term:
+			score = scoreNameExact
+		case strings.HasPrefix(lowerName, term):
+			score = scoreNamePrefix
+		case strings.Contains(lowerName, term):
+			score = scoreNameSubstring
+		default:
+			for key, value := range labels {
+				if strings.Contains(strings.ToLower(key), term) || strings.Contains(strings.ToLower(value), term) {
+					score = scoreLabelMatch
+					break
+				}
+			}
+		}
+
+		if score == 0 {
+			return 0
+		}
+		total += score
+	}
+
+	return total
+}
@@ -0,0 +1,84 @@
+// Copyright 2022 Gravitational, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package daemon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestScoreMatch(t *testing.T) {
+	labels := map[string]string{"env": "production", "team": "platform"}
+
+	tests := []struct {
+		name   string
+		search string
+		score  int
+	}{
+		{
+			name:   "exact name match",
+			search: "postgres-prod",
+			score:  scoreNameExact,
+		},
+		{
+			name:   "name prefix match",
+			search: "postgres",
+			score:  scoreNamePrefix,
+		},
+		{
+			name:   "name substring match",
+			search: "prod",
+			score:  scoreNameSubstring,
+		},
+		{
+			name:   "label match",
+			search: "platform",
+			score:  scoreLabelMatch,
+		},
+		{
+			name:   "case insensitive match",
+			search: "POSTGRES",
+			score:  scoreNamePrefix,
+		},
+		{
+			name:   "all terms must match",
+			search: "postgres missing",
+			score:  0,
+		},
+		{
+			name:   "terms accumulate",
+			search: "postgres platform",
+			score:  scoreNamePrefix + scoreLabelMatch,
+		},
+		{
+			name:   "no match",
+			search: "mysql",
+			score:  0,
+		},
+		{
+			name:   "empty search",
+			search: "  ",
+			score:  0,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.score, scoreMatch(tt.search, "postgres-prod", labels))
+		})
+	}
+}
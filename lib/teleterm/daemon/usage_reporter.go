@@ -0,0 +1,158 @@
+// Copyright 2022 Gravitational, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package daemon
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/gravitational/teleport/lib/teleterm/api/uri"
+
+	"github.com/gravitational/trace"
+	"github.com/sirupsen/logrus"
+)
+
+// usageReportInterval is how often buffered usage events are flushed to the submitter.
+const usageReportInterval = 30 * time.Second
+
+// maxUsageEventBatch is the number of buffered events which triggers an early flush.
+const maxUsageEventBatch = 25
+
+// UsageEvent describes a single anonymous usage event, e.g. a connection being made to a resource
+// or a feature being used from the UI.
+type UsageEvent struct {
+	// ClusterURI is the URI of the cluster the event concerns.
+	ClusterURI string
+	// Name is the event name, e.g. "connect.gateway.create".
+	Name string
+	// Metadata holds extra event properties.
+	Metadata map[string]string
+	// OccurredAt is when the event happened.
+	OccurredAt time.Time
+}
+
+// SubmitUsageEventsFunc submits a batch of usage events to the reporting endpoint of the clusters
+// they belong to. Submission is best-effort – errors are logged, not retried.
+type SubmitUsageEventsFunc func(ctx context.Context, events []UsageEvent) error
+
+// usageReporter buffers usage events and periodically submits them in batches, so frequent UI
+// actions don't generate a request per event.
+type usageReporter struct {
+	submit SubmitUsageEventsFunc
+	log    *logrus.Entry
+
+	mu     sync.Mutex
+	events []UsageEvent
+
+	closeContext context.Context
+	close        context.CancelFunc
+}
+
+func newUsageReporter(submit SubmitUsageEventsFunc, log *logrus.Entry) *usageReporter {
+	closeContext, close := context.WithCancel(context.Background())
+	reporter := &usageReporter{
+		submit:       submit,
+		log:          log,
+		closeContext: closeContext,
+		close:        close,
+	}
+
+	go reporter.run()
+
+	return reporter
+}
+
+// AddEvent buffers an event for the next flush.
+func (r *usageReporter) AddEvent(event UsageEvent) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.events = append(r.events, event)
+
+	if len(r.events) >= maxUsageEventBatch {
+		go r.flush()
+	}
+}
+
+func (r *usageReporter) run() {
+	ticker := time.NewTicker(usageReportInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			r.flush()
+		case <-r.closeContext.Done():
+			r.flush()
+			return
+		}
+	}
+}
+
+// flush submits the buffered events. Events are dropped on submission failure – usage reporting
+// must never block or degrade the daemon.
+func (r *usageReporter) flush() {
+	r.mu.Lock()
+	events := r.events
+	r.events = nil
+	r.mu.Unlock()
+
+	if len(events) == 0 {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), usageReportInterval)
+	defer cancel()
+
+	if err := r.submit(ctx, events); err != nil {
+		r.log.WithError(err).Debugf("Failed to submit %v usage events.", len(events))
+	}
+}
+
+// Stop flushes the remaining events and stops the reporter.
+func (r *usageReporter) Stop() {
+	r.close()
+}
+
+// ReportUsageEvent buffers a usage event for batched submission. It is a noop when usage
+// reporting is disabled or no submitter is configured.
+func (s *Service) ReportUsageEvent(clusterURI, name string, metadata map[string]string) error {
+	if name == "" {
+		return trace.BadParameter("missing event name")
+	}
+	if uri.New(clusterURI).GetProfileName() == "" {
+		return trace.BadParameter("invalid cluster URI: %v", clusterURI)
+	}
+
+	s.reportUsageEvent(clusterURI, name, metadata)
+
+	return nil
+}
+
+// reportUsageEvent is the internal hook used by the daemon itself, e.g. to count resource
+// connections.
+func (s *Service) reportUsageEvent(clusterURI, name string, metadata map[string]string) {
+	if s.usageReporter == nil {
+		return
+	}
+
+	s.usageReporter.AddEvent(UsageEvent{
+		ClusterURI: clusterURI,
+		Name:       name,
+		Metadata:   metadata,
+		OccurredAt: time.Now().UTC(),
+	})
+}
@@ -0,0 +1,80 @@
+// Copyright 2022 Gravitational, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package daemon
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gravitational/trace"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUsageReporterFlushesFullBatch(t *testing.T) {
+	batches := make(chan []UsageEvent, 1)
+	reporter := newUsageReporter(func(ctx context.Context, events []UsageEvent) error {
+		batches <- events
+		return nil
+	}, logrus.NewEntry(logrus.StandardLogger()))
+	t.Cleanup(reporter.Stop)
+
+	for i := 0; i < maxUsageEventBatch; i++ {
+		reporter.AddEvent(UsageEvent{ClusterURI: "/clusters/foo", Name: "connect.test"})
+	}
+
+	select {
+	case events := <-batches:
+		require.Len(t, events, maxUsageEventBatch)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the batch to be submitted")
+	}
+}
+
+func TestUsageReporterFlushesOnStop(t *testing.T) {
+	batches := make(chan []UsageEvent, 1)
+	reporter := newUsageReporter(func(ctx context.Context, events []UsageEvent) error {
+		batches <- events
+		return nil
+	}, logrus.NewEntry(logrus.StandardLogger()))
+
+	reporter.AddEvent(UsageEvent{ClusterURI: "/clusters/foo", Name: "connect.test"})
+	reporter.Stop()
+
+	select {
+	case events := <-batches:
+		require.Len(t, events, 1)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the batch to be submitted")
+	}
+}
+
+func TestUsageReporterDropsEventsOnSubmitError(t *testing.T) {
+	calls := make(chan int, 2)
+	reporter := newUsageReporter(func(ctx context.Context, events []UsageEvent) error {
+		calls <- len(events)
+		return trace.ConnectionProblem(nil, "reporting endpoint is down")
+	}, logrus.NewEntry(logrus.StandardLogger()))
+
+	reporter.AddEvent(UsageEvent{ClusterURI: "/clusters/foo", Name: "connect.test"})
+	reporter.flush()
+	reporter.flush()
+	reporter.Stop()
+
+	require.Equal(t, 1, <-calls)
+	// Failed events are not retried, so the later flushes have nothing to submit.
+	require.Empty(t, calls)
+}
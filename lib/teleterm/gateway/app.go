@@ -0,0 +1,27 @@
+/*
+Copyright 2022 Gravitational, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gateway
+
+const (
+	// ProtocolAppTCP is the value of Config.Protocol for gateways targeting a TCP app. The
+	// gateway forwards raw TCP traffic from the local listener to the app.
+	ProtocolAppTCP = "tcp"
+	// ProtocolAppHTTP is the value of Config.Protocol for gateways targeting a web app. The
+	// gateway forwards plain HTTP traffic from the local listener to the app, the per-app certs
+	// are presented to the proxy by the gateway itself.
+	ProtocolAppHTTP = "http"
+)
@@ -0,0 +1,96 @@
+// Copyright 2022 Gravitational, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"time"
+
+	"github.com/gravitational/trace"
+)
+
+const (
+	// certRenewalBuffer is how long before cert expiry the renewal is attempted, so clients
+	// connected through the gateway don't hit mid-query failures.
+	certRenewalBuffer = 5 * time.Minute
+	// certRenewalRetryInterval is how long to wait before retrying a failed renewal.
+	certRenewalRetryInterval = 30 * time.Second
+)
+
+// CertRenewer reissues the certs of a gateway target and returns the new TLS certificate. The
+// renewal may block on a per-session MFA check.
+type CertRenewer func(ctx context.Context) (tls.Certificate, error)
+
+// startCertRenewal spawns a goroutine which renews the gateway certs shortly before they expire
+// and swaps them in the local proxy. The goroutine stops when the gateway is closed.
+func (g *Gateway) startCertRenewal(notAfter time.Time) {
+	go func() {
+		for {
+			wait := time.Until(notAfter.Add(-certRenewalBuffer))
+			if wait < 0 {
+				wait = 0
+			}
+
+			select {
+			case <-g.closeContext.Done():
+				return
+			case <-time.After(wait):
+			}
+
+			tlsCert, err := g.cfg.CertRenewer(g.closeContext)
+			if err != nil {
+				if g.closeContext.Err() != nil {
+					return
+				}
+				g.cfg.Log.WithError(err).Warn("Failed to renew the gateway certs.")
+				select {
+				case <-g.closeContext.Done():
+					return
+				case <-time.After(certRenewalRetryInterval):
+				}
+				continue
+			}
+
+			g.localProxy.SetCerts([]tls.Certificate{tlsCert})
+
+			expiry, err := certExpiry(tlsCert)
+			if err != nil {
+				// The renewed certs are already in use, so keep the goroutine alive and
+				// schedule the next renewal a conservative interval from now.
+				g.cfg.Log.WithError(err).Warn("Failed to read the expiry of the renewed certs.")
+				notAfter = time.Now().Add(certRenewalBuffer + certRenewalRetryInterval)
+				continue
+			}
+
+			notAfter = expiry
+			g.cfg.Log.Debugf("Renewed the gateway certs, new expiry %v.", notAfter)
+		}
+	}()
+}
+
+// certExpiry returns the expiry of the leaf certificate.
+func certExpiry(tlsCert tls.Certificate) (time.Time, error) {
+	leaf := tlsCert.Leaf
+	if leaf == nil {
+		var err error
+		leaf, err = x509.ParseCertificate(tlsCert.Certificate[0])
+		if err != nil {
+			return time.Time{}, trace.Wrap(err)
+		}
+	}
+	return leaf.NotAfter, nil
+}
@@ -48,6 +48,10 @@ type Config struct {
 	LocalAddress string
 	// Protocol is the gateway protocol
 	Protocol string
+	// KubeconfigPath is the path under which a kube gateway writes an ephemeral kubeconfig
+	// pointing kube clients at the gateway. Required when Protocol is ProtocolKube, unused
+	// otherwise.
+	KubeconfigPath string
 	// CertPath
 	CertPath string
 	// KeyPath
@@ -63,6 +67,10 @@ type Config struct {
 	// TCPPortAllocator creates listeners on the given ports. This interface lets us avoid occupying
 	// hardcoded ports in tests.
 	TCPPortAllocator TCPPortAllocator
+	// CertRenewer reissues the certs of the gateway target shortly before they expire so
+	// long-lived client connections don't hit mid-query failures. Optional; when not set the
+	// certs are loaded only once at gateway creation.
+	CertRenewer CertRenewer
 }
 
 // CheckAndSetDefaults checks and sets the defaults
@@ -99,6 +107,10 @@ func (c *Config) CheckAndSetDefaults() error {
 		return trace.BadParameter("missing CLICommandProvider")
 	}
 
+	if c.Protocol == ProtocolKube && c.KubeconfigPath == "" {
+		return trace.BadParameter("missing kubeconfig path")
+	}
+
 	if c.TCPPortAllocator == nil {
 		c.TCPPortAllocator = NetTCPPortAllocator{}
 	}
@@ -23,6 +23,7 @@ import (
 	"net"
 	"strconv"
 
+	"github.com/gravitational/teleport/api/constants"
 	"github.com/gravitational/teleport/api/utils/keys"
 	alpn "github.com/gravitational/teleport/lib/srv/alpnproxy"
 	alpncommon "github.com/gravitational/teleport/lib/srv/alpnproxy/common"
@@ -65,14 +66,29 @@ func New(cfg Config) (*Gateway, error) {
 		return nil, trace.Wrap(err)
 	}
 
-	protocol, err := alpncommon.ToALPNProtocol(cfg.Protocol)
+	address, err := utils.ParseAddr(cfg.WebProxyAddr)
 	if err != nil {
 		return nil, trace.Wrap(err)
 	}
 
-	address, err := utils.ParseAddr(cfg.WebProxyAddr)
-	if err != nil {
-		return nil, trace.Wrap(err)
+	var protocols []alpncommon.Protocol
+	sni := address.Host()
+	switch cfg.Protocol {
+	case ProtocolKube:
+		// The proxy routes kube traffic based on the SNI prefix rather than a dedicated ALPN
+		// protocol, so the gateway connects with the prefixed SNI and plain HTTP protocols.
+		protocols = []alpncommon.Protocol{alpncommon.ProtocolHTTP2, alpncommon.ProtocolHTTP}
+		sni = constants.KubeTeleportProxyALPNPrefix + address.Host()
+	case ProtocolAppTCP:
+		protocols = []alpncommon.Protocol{alpncommon.ProtocolTCP}
+	case ProtocolAppHTTP:
+		protocols = []alpncommon.Protocol{alpncommon.ProtocolHTTP}
+	default:
+		protocol, err := alpncommon.ToALPNProtocol(cfg.Protocol)
+		if err != nil {
+			return nil, trace.Wrap(err)
+		}
+		protocols = []alpncommon.Protocol{protocol}
 	}
 
 	tlsCert, err := keys.LoadX509KeyPair(cfg.CertPath, cfg.KeyPath)
@@ -83,10 +99,10 @@ func New(cfg Config) (*Gateway, error) {
 	localProxy, err := alpn.NewLocalProxy(alpn.LocalProxyConfig{
 		InsecureSkipVerify: cfg.Insecure,
 		RemoteProxyAddr:    cfg.WebProxyAddr,
-		Protocols:          []alpncommon.Protocol{protocol},
+		Protocols:          protocols,
 		Listener:           listener,
 		ParentContext:      closeContext,
-		SNI:                address.Host(),
+		SNI:                sni,
 		Certs:              []tls.Certificate{tlsCert},
 	})
 	if err != nil {
@@ -95,6 +111,12 @@ func New(cfg Config) (*Gateway, error) {
 
 	cfg.LocalPort = port
 
+	if cfg.Protocol == ProtocolKube {
+		if err := writeKubeconfig(&cfg); err != nil {
+			return nil, trace.Wrap(err)
+		}
+	}
+
 	gateway := &Gateway{
 		cfg:          &cfg,
 		closeContext: closeContext,
@@ -102,6 +124,14 @@ func New(cfg Config) (*Gateway, error) {
 		localProxy:   localProxy,
 	}
 
+	if cfg.CertRenewer != nil {
+		expiry, err := certExpiry(tlsCert)
+		if err != nil {
+			return nil, trace.Wrap(err)
+		}
+		gateway.startCertRenewal(expiry)
+	}
+
 	ok = true
 	return gateway, nil
 }
@@ -128,6 +158,10 @@ func (g *Gateway) Close() error {
 		return trace.Wrap(err)
 	}
 
+	if err := removeKubeconfig(g.cfg); err != nil {
+		return trace.Wrap(err)
+	}
+
 	return nil
 }
 
@@ -168,6 +202,12 @@ func (g *Gateway) TargetUser() string {
 	return g.cfg.TargetUser
 }
 
+// KubeconfigPath returns the path to the ephemeral kubeconfig of a kube gateway. Returns an empty
+// string for other gateways.
+func (g *Gateway) KubeconfigPath() string {
+	return g.cfg.KubeconfigPath
+}
+
 func (g *Gateway) TargetSubresourceName() string {
 	return g.cfg.TargetSubresourceName
 }
@@ -177,3 +177,29 @@ func serveGatewayAndBlockUntilItAcceptsConnections(t *testing.T, gateway *Gatewa
 
 	gatewaytest.BlockUntilGatewayAcceptsConnections(t, address)
 }
+
+func TestAppGatewayStartsListener(t *testing.T) {
+	hs := httptest.NewTLSServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {}))
+	t.Cleanup(func() {
+		hs.Close()
+	})
+
+	gateway, err := New(
+		Config{
+			TargetName:         "foo",
+			TargetURI:          uri.NewClusterURI("bar").AppendApp("foo").String(),
+			Protocol:           ProtocolAppHTTP,
+			CertPath:           "../../../fixtures/certs/proxy1.pem",
+			KeyPath:            "../../../fixtures/certs/proxy1-key.pem",
+			Insecure:           true,
+			WebProxyAddr:       hs.Listener.Addr().String(),
+			CLICommandProvider: mockCLICommandProvider{},
+			TCPPortAllocator:   &gatewaytest.MockTCPPortAllocator{},
+		},
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { gateway.Close() })
+
+	require.NotEmpty(t, gateway.LocalPort())
+	require.NotEqual(t, "0", gateway.LocalPort())
+}
@@ -0,0 +1,90 @@
+/*
+Copyright 2022 Gravitational, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gateway
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+
+	"github.com/gravitational/trace"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+// ProtocolKube is the value of Config.Protocol for gateways targeting a kube cluster rather than
+// a database.
+const ProtocolKube = "kube"
+
+// writeKubeconfig writes an ephemeral kubeconfig pointing kube clients at the gateway listener.
+// The gateway terminates TLS towards the proxy itself using the kube access certs, so the local
+// side speaks plain HTTP and the kubeconfig carries no credentials.
+func writeKubeconfig(cfg *Config) error {
+	contextName := cfg.TargetName
+
+	config := clientcmdapi.NewConfig()
+	config.Clusters[contextName] = &clientcmdapi.Cluster{
+		Server: kubeconfigServer(cfg),
+	}
+	config.AuthInfos[contextName] = clientcmdapi.NewAuthInfo()
+	config.Contexts[contextName] = &clientcmdapi.Context{
+		Cluster:  contextName,
+		AuthInfo: contextName,
+	}
+	config.CurrentContext = contextName
+
+	if err := os.MkdirAll(filepath.Dir(cfg.KubeconfigPath), 0700); err != nil {
+		return trace.ConvertSystemError(err)
+	}
+
+	return trace.Wrap(clientcmd.WriteToFile(*config, cfg.KubeconfigPath))
+}
+
+// removeKubeconfig removes the ephemeral kubeconfig of a kube gateway. It is a no-op for other
+// gateways and when the kubeconfig is already gone. The kubeconfig is also left alone when it no
+// longer points at this gateway, which happens when a replacement gateway listening on a
+// different local port has already overwritten it.
+func removeKubeconfig(cfg *Config) error {
+	if cfg.KubeconfigPath == "" {
+		return nil
+	}
+
+	config, err := clientcmd.LoadFromFile(cfg.KubeconfigPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return trace.Wrap(err)
+	}
+
+	cluster := config.Clusters[cfg.TargetName]
+	if cluster == nil || cluster.Server != kubeconfigServer(cfg) {
+		return nil
+	}
+
+	if err := os.Remove(cfg.KubeconfigPath); err != nil && !os.IsNotExist(err) {
+		return trace.ConvertSystemError(err)
+	}
+
+	return nil
+}
+
+// kubeconfigServer returns the address kube clients reach the gateway at.
+func kubeconfigServer(cfg *Config) string {
+	return fmt.Sprintf("http://%s", net.JoinHostPort(cfg.LocalAddress, cfg.LocalPort))
+}
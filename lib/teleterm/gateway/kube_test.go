@@ -0,0 +1,90 @@
+// Copyright 2022 Gravitational, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gravitational/teleport/lib/teleterm/api/uri"
+	"github.com/gravitational/teleport/lib/teleterm/gatewaytest"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+func TestKubeGatewayWritesAndRemovesKubeconfig(t *testing.T) {
+	gateway := createKubeGateway(t)
+
+	config, err := clientcmd.LoadFromFile(gateway.KubeconfigPath())
+	require.NoError(t, err)
+
+	cluster := config.Clusters["kube1"]
+	require.NotNil(t, cluster)
+	expectedServer := fmt.Sprintf("http://%s", net.JoinHostPort(gateway.LocalAddress(), gateway.LocalPort()))
+	require.Equal(t, expectedServer, cluster.Server)
+	require.Equal(t, "kube1", config.CurrentContext)
+
+	require.NoError(t, gateway.Close())
+
+	_, err = os.Stat(gateway.KubeconfigPath())
+	require.True(t, os.IsNotExist(err), "Expected the kubeconfig to be removed on Close()")
+}
+
+func TestKubeGatewayCloseKeepsKubeconfigOfReplacementGateway(t *testing.T) {
+	oldGateway := createKubeGateway(t)
+
+	newGateway, err := NewWithLocalPort(*oldGateway, "12345")
+	require.NoError(t, err)
+	t.Cleanup(func() { newGateway.Close() })
+
+	// The new gateway overwrote the kubeconfig, closing the old gateway must keep it around.
+	require.NoError(t, oldGateway.Close())
+
+	config, err := clientcmd.LoadFromFile(newGateway.KubeconfigPath())
+	require.NoError(t, err)
+	expectedServer := fmt.Sprintf("http://%s", net.JoinHostPort(newGateway.LocalAddress(), newGateway.LocalPort()))
+	require.Equal(t, expectedServer, config.Clusters["kube1"].Server)
+}
+
+func createKubeGateway(t *testing.T) *Gateway {
+	hs := httptest.NewTLSServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {}))
+	t.Cleanup(func() {
+		hs.Close()
+	})
+
+	gateway, err := New(
+		Config{
+			TargetName:         "kube1",
+			TargetURI:          uri.NewClusterURI("bar").AppendKube("kube1").String(),
+			Protocol:           ProtocolKube,
+			KubeconfigPath:     filepath.Join(t.TempDir(), "kube1-kubeconfig"),
+			CertPath:           "../../../fixtures/certs/proxy1.pem",
+			KeyPath:            "../../../fixtures/certs/proxy1-key.pem",
+			Insecure:           true,
+			WebProxyAddr:       hs.Listener.Addr().String(),
+			CLICommandProvider: mockCLICommandProvider{},
+			TCPPortAllocator:   &gatewaytest.MockTCPPortAllocator{},
+		},
+	)
+	require.NoError(t, err)
+
+	return gateway
+}
@@ -42,13 +42,33 @@ func Serve(ctx context.Context, cfg Config) error {
 		return trace.Wrap(err)
 	}
 
-	daemonService, err := daemon.New(daemon.Config{
+	daemonCfg := daemon.Config{
 		Storage: storage,
-	})
+	}
+
+	// The tshd events service is served by the Electron app. Without it the daemon cannot ask
+	// the app to interact with the user, so relogin prompts and notifications are disabled.
+	if cfg.TshdEventsServerAddr != "" {
+		tshdEventsClient, err := newTshdEventsClient(cfg.TshdEventsServerAddr, cfg.CertsDir)
+		if err != nil {
+			return trace.Wrap(err)
+		}
+
+		daemonCfg.Relogin = newReloginFunc(tshdEventsClient)
+		daemonCfg.SendNotification = newSendNotificationFunc(tshdEventsClient)
+	}
+
+	daemonService, err := daemon.New(daemonCfg)
 	if err != nil {
 		return trace.Wrap(err)
 	}
 
+	// Recreate the gateways that were open when the daemon was previously stopped. A failure
+	// here shouldn't prevent the daemon from starting.
+	if err := daemonService.RestoreGateways(ctx); err != nil {
+		log.WithError(err).Warn("Failed to restore gateways from the previous session.")
+	}
+
 	apiServer, err := apiserver.New(apiserver.Config{
 		HostAddr: cfg.Addr,
 		Daemon:   daemonService,
@@ -0,0 +1,124 @@
+// Copyright 2022 Gravitational, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package teleterm
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"os"
+	"path/filepath"
+	"time"
+
+	api "github.com/gravitational/teleport/lib/teleterm/api/protogen/golang/v1"
+	"github.com/gravitational/teleport/lib/teleterm/daemon"
+	"github.com/gravitational/teleport/lib/utils"
+
+	"github.com/gravitational/trace"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// tshdEventsClientCertFileName is the name of the cert file created by Connect in the certs dir.
+// It authenticates the app both as a client of the daemon API and as the server of the tshd
+// events service.
+const tshdEventsClientCertFileName = "client.crt"
+
+// sendNotificationTimeout bounds the delivery of a single notification. Notifications are
+// fire-and-forget, a hanging events server shouldn't block the daemon watchers.
+const sendNotificationTimeout = 10 * time.Second
+
+// newTshdEventsClient dials the tshd events service served by the Electron app. The connection
+// is established lazily, so the client can be created before the app starts its server.
+func newTshdEventsClient(addr, certsDir string) (api.TshdEventsServiceClient, error) {
+	parsedAddr, err := utils.ParseAddr(addr)
+	if err != nil {
+		return nil, trace.BadParameter("invalid tshd events server address: %s", addr)
+	}
+
+	creds := insecure.NewCredentials()
+	target := parsedAddr.Addr
+	if parsedAddr.Network() == "unix" {
+		target = "unix://" + parsedAddr.Addr
+	} else {
+		// Mirror the API server credentials: the cert Connect writes to the certs dir
+		// authenticates the app on both sides of the connection.
+		caCert, err := os.ReadFile(filepath.Join(certsDir, tshdEventsClientCertFileName))
+		if err != nil {
+			return nil, trace.Wrap(err, "failed to read the tshd events server certificate")
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caCert) {
+			return nil, trace.BadParameter("failed to parse the tshd events server certificate")
+		}
+
+		creds = credentials.NewTLS(&tls.Config{RootCAs: pool})
+	}
+
+	conn, err := grpc.Dial(target, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	return api.NewTshdEventsServiceClient(conn), nil
+}
+
+// newReloginFunc returns a daemon.ReloginFunc that sends the Relogin tshd event and blocks until
+// the user completes or dismisses the login flow.
+func newReloginFunc(client api.TshdEventsServiceClient) daemon.ReloginFunc {
+	return func(ctx context.Context, rootClusterURI string) error {
+		_, err := client.Relogin(ctx, &api.ReloginRequest{
+			RootClusterUri: rootClusterURI,
+		})
+		return trace.Wrap(err)
+	}
+}
+
+// newSendNotificationFunc returns a daemon.SendNotificationFunc that delivers daemon-originated
+// notifications to the app. Delivery failures are logged, notifications are best-effort.
+func newSendNotificationFunc(client api.TshdEventsServiceClient) daemon.SendNotificationFunc {
+	return func(notification daemon.Notification) {
+		req := &api.SendNotificationRequest{
+			ClusterUri: notification.ClusterURI,
+		}
+		switch {
+		case notification.AccessRequestUpdate != nil:
+			req.Subject = &api.SendNotificationRequest_AccessRequestUpdate_{
+				AccessRequestUpdate: &api.SendNotificationRequest_AccessRequestUpdate{
+					RequestId: notification.AccessRequestUpdate.RequestID,
+					State:     notification.AccessRequestUpdate.State,
+				},
+			}
+		case notification.CertExpiry != nil:
+			req.Subject = &api.SendNotificationRequest_CertExpiry_{
+				CertExpiry: &api.SendNotificationRequest_CertExpiry{
+					NotAfter:     notification.CertExpiry.NotAfter.Unix(),
+					AssumedRoles: notification.CertExpiry.AssumedRoles,
+				},
+			}
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), sendNotificationTimeout)
+		defer cancel()
+
+		if _, err := client.SendNotification(ctx, req); err != nil {
+			log.WithError(err).Warn("Failed to deliver a notification to the app.")
+		}
+	}
+}
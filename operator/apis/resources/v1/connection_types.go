@@ -0,0 +1,61 @@
+/*
+Copyright 2022 Gravitational, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func init() {
+	SchemeBuilder.Register(&TeleportConnection{}, &TeleportConnectionList{})
+}
+
+// TeleportConnectionSpec defines how the operator connects to a Teleport cluster
+type TeleportConnectionSpec struct {
+	// SecretName is the name of a Secret in the same namespace holding the address
+	// of the Teleport auth or proxy server under the "addr" key and a tbot-renewed
+	// identity file under the "identity" key.
+	SecretName string `json:"secretName,omitempty"`
+}
+
+// TeleportConnectionStatus defines the observed state of TeleportConnection
+type TeleportConnectionStatus struct {
+	// Conditions represent the latest available observations of an object's state
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+
+// TeleportConnection is the Schema for the connections API
+type TeleportConnection struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TeleportConnectionSpec   `json:"spec,omitempty"`
+	Status TeleportConnectionStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// TeleportConnectionList contains a list of TeleportConnection
+type TeleportConnectionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TeleportConnection `json:"items"`
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2022 Gravitational, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/gravitational/teleport/api/types"
+	"github.com/gravitational/teleport/operator/apis/resources"
+)
+
+func init() {
+	SchemeBuilder.Register(&TeleportClusterNetworkingConfig{}, &TeleportClusterNetworkingConfigList{})
+}
+
+// TeleportClusterNetworkingConfigSpec defines the desired state of TeleportClusterNetworkingConfig
+type TeleportClusterNetworkingConfigSpec types.ClusterNetworkingConfigSpecV2
+
+// TeleportClusterNetworkingConfigStatus defines the observed state of TeleportClusterNetworkingConfig
+type TeleportClusterNetworkingConfigStatus struct {
+	// Conditions represent the latest available observations of an object's state
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// +optional
+	TeleportResourceID int64 `json:"teleportResourceID,omitempty"`
+	// ObservedGeneration is the generation of the Kubernetes resource the status
+	// was last computed for
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// TeleportResourceName is the name of the matching resource in Teleport
+	// +optional
+	TeleportResourceName string `json:"teleportResourceName,omitempty"`
+	// LastSyncTime is the last time the resource was successfully synced with Teleport
+	// +optional
+	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+
+// TeleportClusterNetworkingConfig is the Schema for the clusternetworkingconfigs API.
+// The Teleport cluster_networking_config is a singleton: a single
+// TeleportClusterNetworkingConfig named "cluster-networking-config" manages
+// it, any other instance is rejected by the controller.
+type TeleportClusterNetworkingConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TeleportClusterNetworkingConfigSpec   `json:"spec,omitempty"`
+	Status TeleportClusterNetworkingConfigStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// TeleportClusterNetworkingConfigList contains a list of TeleportClusterNetworkingConfig
+type TeleportClusterNetworkingConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TeleportClusterNetworkingConfig `json:"items"`
+}
+
+func (c TeleportClusterNetworkingConfig) ToTeleport() types.ClusterNetworkingConfig {
+	return &types.ClusterNetworkingConfigV2{
+		Kind:    types.KindClusterNetworkingConfig,
+		Version: types.V2,
+		Metadata: types.Metadata{
+			Name:        types.MetaNameClusterNetworkingConfig,
+			Labels:      c.Labels,
+			Description: c.Annotations[resources.DescriptionKey],
+		},
+		Spec: types.ClusterNetworkingConfigSpecV2(c.Spec),
+	}
+}
+
+// Marshal serializes a spec into binary data.
+func (spec *TeleportClusterNetworkingConfigSpec) Marshal() ([]byte, error) {
+	return (*types.ClusterNetworkingConfigSpecV2)(spec).Marshal()
+}
+
+// Unmarshal deserializes a spec from binary data.
+func (spec *TeleportClusterNetworkingConfigSpec) Unmarshal(data []byte) error {
+	return (*types.ClusterNetworkingConfigSpecV2)(spec).Unmarshal(data)
+}
+
+// DeepCopyInto deep-copies one cluster networking config spec into another.
+// Required to satisfy runtime.Object interface.
+func (spec *TeleportClusterNetworkingConfigSpec) DeepCopyInto(out *TeleportClusterNetworkingConfigSpec) {
+	data, err := spec.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	*out = TeleportClusterNetworkingConfigSpec{}
+	if err = out.Unmarshal(data); err != nil {
+		panic(err)
+	}
+}
@@ -37,6 +37,16 @@ type TeleportUserStatus struct {
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
 	// +optional
 	TeleportResourceID int64 `json:"teleportResourceID,omitempty"`
+	// ObservedGeneration is the generation of the Kubernetes resource the status
+	// was last computed for
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// TeleportResourceName is the name of the matching resource in Teleport
+	// +optional
+	TeleportResourceName string `json:"teleportResourceName,omitempty"`
+	// LastSyncTime is the last time the resource was successfully synced with Teleport
+	// +optional
+	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
 }
 
 //+kubebuilder:object:root=true
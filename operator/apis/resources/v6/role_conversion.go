@@ -0,0 +1,41 @@
+/*
+Copyright 2022 Gravitational, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v6
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	v5 "github.com/gravitational/teleport/operator/apis/resources/v5"
+)
+
+// ConvertTo converts this TeleportRole to the Hub version (v5).
+func (r *TeleportRole) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v5.TeleportRole)
+	dst.ObjectMeta = r.ObjectMeta
+	dst.Spec = v5.TeleportRoleSpec(r.Spec)
+	dst.Status = v5.TeleportRoleStatus(r.Status)
+	return nil
+}
+
+// ConvertFrom converts from the Hub version (v5) to this version.
+func (r *TeleportRole) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v5.TeleportRole)
+	r.ObjectMeta = src.ObjectMeta
+	r.Spec = TeleportRoleSpec(src.Spec)
+	r.Status = TeleportRoleStatus(src.Status)
+	return nil
+}
@@ -0,0 +1,173 @@
+/*
+Copyright 2022 Gravitational, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gravitational/trace"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gravitational/teleport/api/types"
+	resourcesv2 "github.com/gravitational/teleport/operator/apis/resources/v2"
+	"github.com/gravitational/teleport/operator/sidecar"
+)
+
+// AuthPreferenceReconciler reconciles a TeleportAuthPreference object
+type AuthPreferenceReconciler struct {
+	kclient.Client
+	Scheme                 *runtime.Scheme
+	TeleportClientAccessor sidecar.ClientAccessor
+	KeepResourcesOnDelete  bool
+	DryRun                 bool
+	Recorder               record.EventRecorder
+}
+
+//+kubebuilder:rbac:groups=resources.teleport.dev,resources=authpreferences,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=resources.teleport.dev,resources=authpreferences/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=resources.teleport.dev,resources=authpreferences/finalizers,verbs=update
+
+// Reconcile is part of the main kubernetes reconciliation loop which aims to
+// move the current state of the cluster closer to the desired state.
+func (r *AuthPreferenceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	return ResourceBaseReconciler{
+		Client:                r.Client,
+		Kind:                  "TeleportAuthPreference",
+		KeepResourcesOnDelete: r.KeepResourcesOnDelete,
+		DryRun:                r.DryRun,
+		Recorder:              r.Recorder,
+		DeleteExternal:        r.Delete,
+		UpsertExternal:        r.Upsert,
+	}.Do(ctx, req, &resourcesv2.TeleportAuthPreference{})
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *AuthPreferenceReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&resourcesv2.TeleportAuthPreference{}).
+		Complete(r)
+}
+
+func (r *AuthPreferenceReconciler) Delete(ctx context.Context, obj kclient.Object) error {
+	// Conflicting instances never made it to Teleport, there is nothing to delete for them.
+	if obj.GetName() != types.MetaNameClusterAuthPreference {
+		return nil
+	}
+	teleportClient, err := clientForResource(ctx, r.Client, obj, r.TeleportClientAccessor)
+	if err != nil {
+		return trace.Wrap(err)
+	}
+	// cluster_auth_preference always exists, deleting the Kubernetes resource restores the defaults.
+	return teleportClient.ResetAuthPreference(ctx)
+}
+
+func (r *AuthPreferenceReconciler) Upsert(ctx context.Context, obj kclient.Object) error {
+	k8sResource, ok := obj.(*resourcesv2.TeleportAuthPreference)
+	if !ok {
+		return fmt.Errorf("failed to convert Object into resource object: %T", obj)
+	}
+
+	// A paused resource is frozen: the reconciliation stops here and nothing is
+	// propagated to Teleport until the annotation is removed.
+	if isPaused(k8sResource) {
+		meta.SetStatusCondition(&k8sResource.Status.Conditions, getPausedCondition(true))
+		return trace.Wrap(r.Status().Update(ctx, k8sResource))
+	}
+	meta.SetStatusCondition(&k8sResource.Status.Conditions, getPausedCondition(false))
+
+	// cluster_auth_preference is a singleton, accepting arbitrarily-named instances would
+	// let several resources fight over the same Teleport preferences. Only the well-known
+	// name is reconciled, every other instance is rejected with an explicit condition.
+	newSingletonCondition, err := checkSingletonName(k8sResource.GetName(), types.MetaNameClusterAuthPreference)
+	meta.SetStatusCondition(&k8sResource.Status.Conditions, newSingletonCondition)
+	if err != nil {
+		silentUpdateStatus(ctx, r.Client, k8sResource)
+		return trace.Wrap(err)
+	}
+
+	teleportResource := k8sResource.ToTeleport()
+
+	teleportClient, err := clientForResource(ctx, r.Client, obj, r.TeleportClientAccessor)
+	if err != nil {
+		silentUpdateStatus(ctx, r.Client, k8sResource)
+		return trace.Wrap(err)
+	}
+
+	existingResource, err := teleportClient.GetAuthPreference(ctx)
+	if err != nil {
+		silentUpdateStatus(ctx, r.Client, k8sResource)
+		return trace.Wrap(err)
+	}
+
+	// If an error happens we want to put it in status.conditions before returning.
+	newOwnershipCondition, err := checkSingletonOwnership(existingResource)
+	meta.SetStatusCondition(&k8sResource.Status.Conditions, newOwnershipCondition)
+	if err != nil {
+		silentUpdateStatus(ctx, r.Client, k8sResource)
+		return trace.Wrap(err)
+	}
+
+	r.addTeleportResourceOrigin(teleportResource)
+
+	// In dry-run mode the pending changes are reported in the conditions
+	// instead of being applied to Teleport.
+	if isDryRun(k8sResource, r.DryRun) {
+		var diff string
+		if resourceDrifted(existingResource, teleportResource) {
+			diff = resourceDiff(existingResource, teleportResource)
+		}
+		meta.SetStatusCondition(&k8sResource.Status.Conditions, getDryRunConditionFromDiff(diff))
+		return trace.Wrap(r.Status().Update(ctx, k8sResource))
+	}
+
+	// On periodic resync most resources did not drift, skipping the upsert
+	// avoids rewriting the Teleport backend on every sync period.
+	if !resourceDrifted(existingResource, teleportResource) {
+		k8sResource.Status.ObservedGeneration = k8sResource.GetGeneration()
+		k8sResource.Status.TeleportResourceName = teleportResource.GetName()
+		syncTime := metav1.Now()
+		k8sResource.Status.LastSyncTime = &syncTime
+		return trace.Wrap(r.Status().Update(ctx, k8sResource))
+	}
+
+	// If an error happens we want to put it in status.conditions before returning.
+	err = teleportClient.SetAuthPreference(ctx, teleportResource)
+	newReconciliationCondition := getReconciliationConditionFromError(err)
+	meta.SetStatusCondition(&k8sResource.Status.Conditions, newReconciliationCondition)
+	if err != nil {
+		silentUpdateStatus(ctx, r.Client, k8sResource)
+		return trace.Wrap(err)
+	}
+
+	k8sResource.Status.ObservedGeneration = k8sResource.GetGeneration()
+	k8sResource.Status.TeleportResourceName = teleportResource.GetName()
+	syncTime := metav1.Now()
+	k8sResource.Status.LastSyncTime = &syncTime
+
+	// We update the status conditions on exit
+	return trace.Wrap(r.Status().Update(ctx, k8sResource))
+}
+
+func (r *AuthPreferenceReconciler) addTeleportResourceOrigin(resource types.AuthPreference) {
+	resource.SetOrigin(types.OriginKubernetes)
+}
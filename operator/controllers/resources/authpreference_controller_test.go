@@ -0,0 +1,143 @@
+/*
+Copyright 2022 Gravitational, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gravitational/teleport/api/constants"
+	"github.com/gravitational/teleport/api/types"
+	resourcesv2 "github.com/gravitational/teleport/operator/apis/resources/v2"
+)
+
+// When I create the TeleportAuthPreference CR in Kubernetes,
+// the cluster_auth_preference must be updated in Teleport.
+// When I delete it, the Teleport defaults must be restored.
+func TestAuthPreferenceReconciliation(t *testing.T) {
+	ctx := context.Background()
+	setup := setupKubernetesAndTeleport(t)
+
+	// End of setup, we create the auth preference in Kubernetes
+	authPref := resourcesv2.TeleportAuthPreference{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      types.MetaNameClusterAuthPreference,
+			Namespace: setup.namespace.Name,
+		},
+		Spec: resourcesv2.TeleportAuthPreferenceSpec{
+			Type:         constants.Local,
+			SecondFactor: constants.SecondFactorWebauthn,
+			Webauthn: &types.Webauthn{
+				RPID: "example.com",
+			},
+		},
+	}
+	err := setup.k8sClient.Create(ctx, &authPref)
+	require.NoError(t, err)
+
+	// We wait for the auth preference to be updated in Teleport
+	fastEventually(t, func() bool {
+		tAuthPref, err := setup.tClient.GetAuthPreference(ctx)
+		require.NoError(t, err)
+
+		if tAuthPref.Origin() != types.OriginKubernetes {
+			return false
+		}
+
+		require.Equal(t, tAuthPref.GetSecondFactor(), constants.SecondFactorWebauthn)
+		webauthn, err := tAuthPref.GetWebauthn()
+		require.NoError(t, err)
+		require.Equal(t, webauthn.RPID, "example.com")
+
+		return true
+	})
+
+	// Cleanup and setup, we delete the auth preference in Kubernetes
+	err = setup.k8sClient.Delete(ctx, &authPref)
+	require.NoError(t, err)
+
+	// We wait for the Teleport defaults to be restored
+	fastEventually(t, func() bool {
+		tAuthPref, err := setup.tClient.GetAuthPreference(ctx)
+		require.NoError(t, err)
+		return tAuthPref.Origin() == types.OriginDefaults
+	})
+}
+
+// When I create a TeleportAuthPreference CR whose name is not the singleton name,
+// the controller must refuse to reconcile it and leave Teleport untouched.
+func TestAuthPreferenceConflictingInstance(t *testing.T) {
+	ctx := context.Background()
+	setup := setupKubernetesAndTeleport(t)
+	authPrefName := validRandomResourceName("authpref-")
+
+	authPref := resourcesv2.TeleportAuthPreference{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      authPrefName,
+			Namespace: setup.namespace.Name,
+		},
+		Spec: resourcesv2.TeleportAuthPreferenceSpec{
+			Type:         constants.Local,
+			SecondFactor: constants.SecondFactorOTP,
+		},
+	}
+	err := setup.k8sClient.Create(ctx, &authPref)
+	require.NoError(t, err)
+
+	// We wait for the controller to reject the conflicting instance
+	fastEventually(t, func() bool {
+		var k8sAuthPref resourcesv2.TeleportAuthPreference
+		err := setup.k8sClient.Get(ctx, kclient.ObjectKey{
+			Namespace: setup.namespace.Name,
+			Name:      authPrefName,
+		}, &k8sAuthPref)
+		require.NoError(t, err)
+
+		for _, condition := range k8sAuthPref.Status.Conditions {
+			if condition.Type == ConditionTypeValidSingletonName {
+				require.Equal(t, condition.Status, metav1.ConditionFalse)
+				require.Equal(t, condition.Reason, ConditionReasonConflictingInstance)
+				return true
+			}
+		}
+		return false
+	})
+
+	// The Teleport auth preference was not touched
+	tAuthPref, err := setup.tClient.GetAuthPreference(ctx)
+	require.NoError(t, err)
+	require.NotEqual(t, types.OriginKubernetes, tAuthPref.Origin())
+
+	// Teardown, the conflicting instance can still be deleted
+	err = setup.k8sClient.Delete(ctx, &authPref)
+	require.NoError(t, err)
+
+	var k8sAuthPref resourcesv2.TeleportAuthPreference
+	fastEventually(t, func() bool {
+		err := setup.k8sClient.Get(ctx, kclient.ObjectKey{
+			Namespace: setup.namespace.Name,
+			Name:      authPrefName,
+		}, &k8sAuthPref)
+		return kerrors.IsNotFound(err)
+	})
+}
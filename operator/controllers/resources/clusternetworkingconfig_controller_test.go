@@ -0,0 +1,137 @@
+/*
+Copyright 2022 Gravitational, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gravitational/teleport/api/types"
+	resourcesv2 "github.com/gravitational/teleport/operator/apis/resources/v2"
+)
+
+// When I create the TeleportClusterNetworkingConfig CR in Kubernetes,
+// the cluster_networking_config must be updated in Teleport.
+// When I delete it, the Teleport defaults must be restored.
+func TestClusterNetworkingConfigReconciliation(t *testing.T) {
+	ctx := context.Background()
+	setup := setupKubernetesAndTeleport(t)
+
+	// End of setup, we create the networking config in Kubernetes
+	netConfig := resourcesv2.TeleportClusterNetworkingConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      types.MetaNameClusterNetworkingConfig,
+			Namespace: setup.namespace.Name,
+		},
+		Spec: resourcesv2.TeleportClusterNetworkingConfigSpec{
+			KeepAliveInterval:     types.Duration(30 * time.Second),
+			SessionControlTimeout: types.Duration(5 * time.Minute),
+		},
+	}
+	err := setup.k8sClient.Create(ctx, &netConfig)
+	require.NoError(t, err)
+
+	// We wait for the networking config to be updated in Teleport
+	fastEventually(t, func() bool {
+		tNetConfig, err := setup.tClient.GetClusterNetworkingConfig(ctx)
+		require.NoError(t, err)
+
+		if tNetConfig.Origin() != types.OriginKubernetes {
+			return false
+		}
+
+		require.Equal(t, tNetConfig.GetKeepAliveInterval(), 30*time.Second)
+		require.Equal(t, tNetConfig.GetSessionControlTimeout(), 5*time.Minute)
+
+		return true
+	})
+
+	// Cleanup and setup, we delete the networking config in Kubernetes
+	err = setup.k8sClient.Delete(ctx, &netConfig)
+	require.NoError(t, err)
+
+	// We wait for the Teleport defaults to be restored
+	fastEventually(t, func() bool {
+		tNetConfig, err := setup.tClient.GetClusterNetworkingConfig(ctx)
+		require.NoError(t, err)
+		return tNetConfig.Origin() == types.OriginDefaults
+	})
+}
+
+// When I create a TeleportClusterNetworkingConfig CR whose name is not the singleton name,
+// the controller must refuse to reconcile it and leave Teleport untouched.
+func TestClusterNetworkingConfigConflictingInstance(t *testing.T) {
+	ctx := context.Background()
+	setup := setupKubernetesAndTeleport(t)
+	netConfigName := validRandomResourceName("netconfig-")
+
+	netConfig := resourcesv2.TeleportClusterNetworkingConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      netConfigName,
+			Namespace: setup.namespace.Name,
+		},
+		Spec: resourcesv2.TeleportClusterNetworkingConfigSpec{
+			KeepAliveInterval: types.Duration(time.Minute),
+		},
+	}
+	err := setup.k8sClient.Create(ctx, &netConfig)
+	require.NoError(t, err)
+
+	// We wait for the controller to reject the conflicting instance
+	fastEventually(t, func() bool {
+		var k8sNetConfig resourcesv2.TeleportClusterNetworkingConfig
+		err := setup.k8sClient.Get(ctx, kclient.ObjectKey{
+			Namespace: setup.namespace.Name,
+			Name:      netConfigName,
+		}, &k8sNetConfig)
+		require.NoError(t, err)
+
+		for _, condition := range k8sNetConfig.Status.Conditions {
+			if condition.Type == ConditionTypeValidSingletonName {
+				require.Equal(t, condition.Status, metav1.ConditionFalse)
+				require.Equal(t, condition.Reason, ConditionReasonConflictingInstance)
+				return true
+			}
+		}
+		return false
+	})
+
+	// The Teleport networking config was not touched
+	tNetConfig, err := setup.tClient.GetClusterNetworkingConfig(ctx)
+	require.NoError(t, err)
+	require.NotEqual(t, types.OriginKubernetes, tNetConfig.Origin())
+
+	// Teardown, the conflicting instance can still be deleted
+	err = setup.k8sClient.Delete(ctx, &netConfig)
+	require.NoError(t, err)
+
+	var k8sNetConfig resourcesv2.TeleportClusterNetworkingConfig
+	fastEventually(t, func() bool {
+		err := setup.k8sClient.Get(ctx, kclient.ObjectKey{
+			Namespace: setup.namespace.Name,
+			Name:      netConfigName,
+		}, &k8sNetConfig)
+		return kerrors.IsNotFound(err)
+	})
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2022 Gravitational, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gravitational/teleport/lib/auth"
+	resourcesv1 "github.com/gravitational/teleport/operator/apis/resources/v1"
+	"github.com/gravitational/teleport/operator/sidecar"
+	"github.com/gravitational/trace"
+)
+
+// ConnectionAnnotation selects the TeleportConnection a Kubernetes resource reconciles
+// against. The annotation can be set on the resource itself or on its namespace, the
+// resource-level annotation takes precedence. Resources without the annotation use the
+// connection the operator was started with.
+const ConnectionAnnotation = "teleport.dev/connection"
+
+// connectionNameForResource resolves which TeleportConnection applies to a resource.
+// An empty name means the resource uses the operator's own connection.
+func connectionNameForResource(obj kclient.Object, namespace *corev1.Namespace) string {
+	if name := obj.GetAnnotations()[ConnectionAnnotation]; name != "" {
+		return name
+	}
+	if namespace != nil {
+		return namespace.GetAnnotations()[ConnectionAnnotation]
+	}
+	return ""
+}
+
+// clientForResource returns the Teleport client a resource must be reconciled with,
+// honoring the connection annotation on the resource or its namespace. This lets a
+// single operator instance manage resources belonging to several Teleport clusters.
+func clientForResource(ctx context.Context, kube kclient.Client, obj kclient.Object, defaultAccessor sidecar.ClientAccessor) (auth.ClientI, error) {
+	connectionName := connectionNameForResource(obj, nil)
+	if connectionName == "" {
+		namespace := &corev1.Namespace{}
+		if err := kube.Get(ctx, kclient.ObjectKey{Name: obj.GetNamespace()}, namespace); err != nil {
+			return nil, trace.Wrap(err, "failed to read the resource namespace")
+		}
+		connectionName = connectionNameForResource(obj, namespace)
+	}
+	if connectionName == "" {
+		return defaultAccessor(ctx)
+	}
+
+	connection := &resourcesv1.TeleportConnection{}
+	if err := kube.Get(ctx, kclient.ObjectKey{Namespace: obj.GetNamespace(), Name: connectionName}, connection); err != nil {
+		return nil, trace.Wrap(err, "failed to read the TeleportConnection %q", connectionName)
+	}
+	return sidecar.NewSecretClientAccessor(kube, connection.Namespace, connection.Spec.SecretName)(ctx)
+}
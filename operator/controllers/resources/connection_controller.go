@@ -0,0 +1,98 @@
+/*
+Copyright 2022 Gravitational, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"context"
+	"time"
+
+	"github.com/gravitational/trace"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+	ctrllog "sigs.k8s.io/controller-runtime/pkg/log"
+
+	resourcesv1 "github.com/gravitational/teleport/operator/apis/resources/v1"
+	"github.com/gravitational/teleport/operator/sidecar"
+)
+
+// connectionProbeInterval is how often the connectivity of a TeleportConnection is
+// re-checked, so the status conditions stay fresh between resource events.
+const connectionProbeInterval = time.Minute
+
+// ConnectionReconciler reconciles a TeleportConnection object
+type ConnectionReconciler struct {
+	kclient.Client
+	Scheme *runtime.Scheme
+}
+
+//+kubebuilder:rbac:groups=resources.teleport.dev,resources=connections,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=resources.teleport.dev,resources=connections/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+//+kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
+
+// Reconcile probes the Teleport cluster described by the TeleportConnection and
+// reports the connectivity in the status conditions. Unlike the resource
+// controllers nothing is written to Teleport, the connection is purely observed.
+func (r *ConnectionReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := ctrllog.FromContext(ctx).WithValues("namespacedname", req.NamespacedName)
+
+	connection := &resourcesv1.TeleportConnection{}
+	if err := r.Get(ctx, req.NamespacedName, connection); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, trace.Wrap(err)
+	}
+
+	err := r.checkConnection(ctx, connection)
+	meta.SetStatusCondition(&connection.Status.Conditions, getConnectionConditionFromError(err))
+	if err != nil {
+		log.Error(err, "failed to connect to the Teleport cluster")
+		silentUpdateStatus(ctx, r.Client, connection)
+		// The failure is already reported in the status conditions, requeueing
+		// the probe is enough, no need to error out and trigger backoff.
+		return ctrl.Result{RequeueAfter: connectionProbeInterval}, nil
+	}
+
+	if err := r.Status().Update(ctx, connection); err != nil {
+		return ctrl.Result{}, trace.Wrap(err)
+	}
+	return ctrl.Result{RequeueAfter: connectionProbeInterval}, nil
+}
+
+// checkConnection builds a client from the connection credentials and pings the cluster.
+func (r *ConnectionReconciler) checkConnection(ctx context.Context, connection *resourcesv1.TeleportConnection) error {
+	clientAccessor := sidecar.NewSecretClientAccessor(r.Client, connection.Namespace, connection.Spec.SecretName)
+	teleportClient, err := clientAccessor(ctx)
+	if err != nil {
+		return trace.Wrap(err)
+	}
+	defer teleportClient.Close()
+
+	_, err = teleportClient.Ping(ctx)
+	return trace.Wrap(err)
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *ConnectionReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&resourcesv1.TeleportConnection{}).
+		Complete(r)
+}
@@ -0,0 +1,181 @@
+/*
+Copyright 2022 Gravitational, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/gravitational/trace"
+	"github.com/stretchr/testify/require"
+
+	core "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gravitational/teleport/integration"
+	resourcesv1 "github.com/gravitational/teleport/operator/apis/resources/v1"
+	resourcesv2 "github.com/gravitational/teleport/operator/apis/resources/v2"
+	"github.com/gravitational/teleport/operator/sidecar"
+)
+
+// When I create a TeleportConnection CR referencing a Secret with valid credentials,
+// the ConnectionEstablished condition must become true. When the credentials go away,
+// the condition must report the failure.
+func TestConnectionReconciliation(t *testing.T) {
+	ctx := context.Background()
+	teleportServer, operatorName := defaultTeleportServiceConfig(t)
+	require.NoError(t, teleportServer.Start())
+
+	tClient := clientForTeleport(t, teleportServer, operatorName)
+	k8sClient := startKubernetesOperator(t, tClient)
+	ns := createNamespaceForTest(t, k8sClient)
+
+	t.Cleanup(func() {
+		err := tClient.Close()
+		require.NoError(t, err)
+		err = teleportServer.StopAll()
+		require.NoError(t, err)
+	})
+
+	// The credentials Secret holds the cluster address and an identity renewed by tbot
+	identityFilePath := integration.MustCreateUserIdentityFile(t, teleportServer, operatorName, time.Hour)
+	identityContent, err := os.ReadFile(identityFilePath)
+	require.NoError(t, err)
+
+	secretName := validRandomResourceName("credentials-")
+	secret := &core.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      secretName,
+			Namespace: ns.Name,
+		},
+		Data: map[string][]byte{
+			sidecar.SecretAddrKey:     []byte(teleportServer.Auth),
+			sidecar.SecretIdentityKey: identityContent,
+		},
+	}
+	require.NoError(t, k8sClient.Create(ctx, secret))
+
+	connectionName := validRandomResourceName("connection-")
+	connection := &resourcesv1.TeleportConnection{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      connectionName,
+			Namespace: ns.Name,
+		},
+		Spec: resourcesv1.TeleportConnectionSpec{
+			SecretName: secretName,
+		},
+	}
+	require.NoError(t, k8sClient.Create(ctx, connection))
+
+	// The operator reports it can connect to the Teleport cluster
+	fastEventually(t, func() bool {
+		err := k8sClient.Get(ctx, kclient.ObjectKey{Namespace: ns.Name, Name: connectionName}, connection)
+		require.NoError(t, err)
+
+		condition := meta.FindStatusCondition(connection.Status.Conditions, ConditionTypeConnectionEstablished)
+		return condition != nil && condition.Status == metav1.ConditionTrue
+	})
+
+	// Pointing the connection to a missing Secret must be reported in the conditions
+	connection.Spec.SecretName = validRandomResourceName("missing-")
+	require.NoError(t, k8sClient.Update(ctx, connection))
+
+	fastEventually(t, func() bool {
+		err := k8sClient.Get(ctx, kclient.ObjectKey{Namespace: ns.Name, Name: connectionName}, connection)
+		require.NoError(t, err)
+
+		condition := meta.FindStatusCondition(connection.Status.Conditions, ConditionTypeConnectionEstablished)
+		return condition != nil && condition.Status == metav1.ConditionFalse &&
+			condition.Reason == ConditionReasonTeleportError
+	})
+}
+
+// When I annotate a resource CR with a connection, the resource must be reconciled
+// against the Teleport cluster targeted by that connection.
+func TestConnectionTargetedReconciliation(t *testing.T) {
+	ctx := context.Background()
+	teleportServer, operatorName := defaultTeleportServiceConfig(t)
+	require.NoError(t, teleportServer.Start())
+
+	tClient := clientForTeleport(t, teleportServer, operatorName)
+	k8sClient := startKubernetesOperator(t, tClient)
+	ns := createNamespaceForTest(t, k8sClient)
+
+	t.Cleanup(func() {
+		err := tClient.Close()
+		require.NoError(t, err)
+		err = teleportServer.StopAll()
+		require.NoError(t, err)
+	})
+
+	identityFilePath := integration.MustCreateUserIdentityFile(t, teleportServer, operatorName, time.Hour)
+	identityContent, err := os.ReadFile(identityFilePath)
+	require.NoError(t, err)
+
+	secretName := validRandomResourceName("credentials-")
+	secret := &core.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      secretName,
+			Namespace: ns.Name,
+		},
+		Data: map[string][]byte{
+			sidecar.SecretAddrKey:     []byte(teleportServer.Auth),
+			sidecar.SecretIdentityKey: identityContent,
+		},
+	}
+	require.NoError(t, k8sClient.Create(ctx, secret))
+
+	connectionName := validRandomResourceName("connection-")
+	connection := &resourcesv1.TeleportConnection{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      connectionName,
+			Namespace: ns.Name,
+		},
+		Spec: resourcesv1.TeleportConnectionSpec{
+			SecretName: secretName,
+		},
+	}
+	require.NoError(t, k8sClient.Create(ctx, connection))
+
+	// The annotated user must be reconciled through the connection credentials
+	userName := validRandomResourceName("user-")
+	user := &resourcesv2.TeleportUser{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        userName,
+			Namespace:   ns.Name,
+			Annotations: map[string]string{ConnectionAnnotation: connectionName},
+		},
+		Spec: resourcesv2.TeleportUserSpec{
+			Roles: []string{"a", "b"},
+		},
+	}
+	require.NoError(t, k8sClient.Create(ctx, user))
+
+	fastEventually(t, func() bool {
+		tUser, err := tClient.GetUser(userName, false)
+		if trace.IsNotFound(err) {
+			return false
+		}
+		require.NoError(t, err)
+
+		return tUser.GetName() == userName
+	})
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2022 Gravitational, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	resourcesv2 "github.com/gravitational/teleport/operator/apis/resources/v2"
+)
+
+func TestConnectionNameForResource(t *testing.T) {
+	tests := []struct {
+		name                 string
+		resourceAnnotations  map[string]string
+		namespaceAnnotations map[string]string
+		expectedConnection   string
+	}{
+		{
+			name:               "no annotations",
+			expectedConnection: "",
+		},
+		{
+			name:                "resource annotation",
+			resourceAnnotations: map[string]string{ConnectionAnnotation: "main"},
+			expectedConnection:  "main",
+		},
+		{
+			name:                 "namespace annotation",
+			namespaceAnnotations: map[string]string{ConnectionAnnotation: "leaf"},
+			expectedConnection:   "leaf",
+		},
+		{
+			name:                 "resource annotation takes precedence over the namespace one",
+			resourceAnnotations:  map[string]string{ConnectionAnnotation: "main"},
+			namespaceAnnotations: map[string]string{ConnectionAnnotation: "leaf"},
+			expectedConnection:   "main",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			obj := &resourcesv2.TeleportUser{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "user",
+					Annotations: tc.resourceAnnotations,
+				},
+			}
+			namespace := &corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "ns",
+					Annotations: tc.namespaceAnnotations,
+				},
+			}
+
+			require.Equal(t, tc.expectedConnection, connectionNameForResource(obj, namespace))
+		})
+	}
+}
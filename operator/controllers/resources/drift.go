@@ -0,0 +1,283 @@
+/*
+Copyright 2022 Gravitational, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+	ctrllog "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/gravitational/teleport/api/types"
+	resourcesv2 "github.com/gravitational/teleport/operator/apis/resources/v2"
+	resourcesv5 "github.com/gravitational/teleport/operator/apis/resources/v5"
+	"github.com/gravitational/teleport/operator/sidecar"
+	"github.com/gravitational/trace"
+)
+
+// driftWatcherRetryPeriod is the time to wait before re-opening the Teleport
+// event watcher after it failed or was closed by the server.
+const driftWatcherRetryPeriod = 5 * time.Second
+
+// DriftWatcher watches the Teleport events for operator-owned resources and
+// reports out-of-band changes (e.g. a tctl edit) in the Drifted status condition
+// of the corresponding CR, without waiting for the next periodic resync.
+// It implements manager.Runnable and manager.LeaderElectionRunnable so it can be
+// added to a controllerruntime.Manager and only runs on the elected leader.
+type DriftWatcher struct {
+	Client                 kclient.Client
+	TeleportClientAccessor sidecar.ClientAccessor
+	// Namespace is the namespace whose CRs are matched with the Teleport events.
+	Namespace string
+	// AutoRevert re-applies the Kubernetes resource as soon as an out-of-band
+	// change is detected, instead of waiting for the next periodic resync.
+	AutoRevert bool
+}
+
+func (w *DriftWatcher) NeedLeaderElection() bool {
+	return true
+}
+
+func (w *DriftWatcher) Start(ctx context.Context) error {
+	log := ctrllog.Log.WithName("drift-watcher")
+	for {
+		if err := w.watch(ctx, log); err != nil {
+			log.Error(err, "teleport event watcher closed, re-opening")
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(driftWatcherRetryPeriod):
+		}
+	}
+}
+
+// watch opens a Teleport event watcher on the reconciled kinds and processes the
+// events until the watcher or the context is closed.
+func (w *DriftWatcher) watch(ctx context.Context, log logr.Logger) error {
+	teleportClient, err := w.TeleportClientAccessor(ctx)
+	if err != nil {
+		return trace.Wrap(err)
+	}
+	watcher, err := teleportClient.NewWatcher(ctx, types.Watch{
+		Kinds: []types.WatchKind{
+			{Kind: types.KindRole},
+			{Kind: types.KindUser},
+			{Kind: types.KindToken},
+		},
+	})
+	if err != nil {
+		return trace.Wrap(err)
+	}
+	defer watcher.Close()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-watcher.Done():
+			return trace.Wrap(watcher.Error())
+		case event := <-watcher.Events():
+			if err := w.handleEvent(ctx, event); err != nil {
+				log.Error(err, "failed to process teleport event", "kind", event.Resource.GetKind(), "name", event.Resource.GetName())
+			}
+		}
+	}
+}
+
+// handleEvent matches a Teleport event with the CR of the same name and updates
+// its Drifted condition. Put events carry the new resource which is compared with
+// the CR, delete events are drift by definition when the CR still exists.
+func (w *DriftWatcher) handleEvent(ctx context.Context, event types.Event) error {
+	if event.Type != types.OpPut && event.Type != types.OpDelete {
+		return nil
+	}
+	// Put events carry the full resource and the origin label can be checked
+	// upfront. Delete events only carry a resource header, the ownership is
+	// established by the existence of a CR with the same name.
+	if event.Type == types.OpPut && !isResourceOriginKubernetes(event.Resource) {
+		return nil
+	}
+	switch event.Resource.GetKind() {
+	case types.KindRole:
+		return trace.Wrap(w.handleRoleEvent(ctx, event))
+	case types.KindUser:
+		return trace.Wrap(w.handleUserEvent(ctx, event))
+	case types.KindToken:
+		return trace.Wrap(w.handleTokenEvent(ctx, event))
+	default:
+		return nil
+	}
+}
+
+func (w *DriftWatcher) handleRoleEvent(ctx context.Context, event types.Event) error {
+	k8sResource := &resourcesv5.TeleportRole{}
+	err := w.Client.Get(ctx, kclient.ObjectKey{Namespace: w.Namespace, Name: event.Resource.GetName()}, k8sResource)
+	if apierrors.IsNotFound(err) {
+		// No CR with that name: an orphan is the garbage collector's job, and a
+		// rotated token is intentionally not named after its CR.
+		return nil
+	}
+	if err != nil {
+		return trace.Wrap(err)
+	}
+	if skipDriftCheck(k8sResource) {
+		return nil
+	}
+
+	desired := k8sResource.ToTeleport()
+	addDriftOriginLabel(desired)
+	diff := driftDiff(event, desired)
+
+	if err := w.updateDriftCondition(ctx, k8sResource, &k8sResource.Status.Conditions, diff); err != nil {
+		return trace.Wrap(err)
+	}
+	if diff == "" || !w.AutoRevert {
+		return nil
+	}
+	teleportClient, err := w.TeleportClientAccessor(ctx)
+	if err != nil {
+		return trace.Wrap(err)
+	}
+	return trace.Wrap(teleportClient.UpsertRole(ctx, desired))
+}
+
+func (w *DriftWatcher) handleUserEvent(ctx context.Context, event types.Event) error {
+	k8sResource := &resourcesv2.TeleportUser{}
+	err := w.Client.Get(ctx, kclient.ObjectKey{Namespace: w.Namespace, Name: event.Resource.GetName()}, k8sResource)
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return trace.Wrap(err)
+	}
+	if skipDriftCheck(k8sResource) {
+		return nil
+	}
+
+	desired := k8sResource.ToTeleport()
+	addDriftOriginLabel(desired)
+	diff := driftDiff(event, desired)
+
+	if err := w.updateDriftCondition(ctx, k8sResource, &k8sResource.Status.Conditions, diff); err != nil {
+		return trace.Wrap(err)
+	}
+	if diff == "" || !w.AutoRevert {
+		return nil
+	}
+	teleportClient, err := w.TeleportClientAccessor(ctx)
+	if err != nil {
+		return trace.Wrap(err)
+	}
+	if event.Type == types.OpDelete {
+		return trace.Wrap(teleportClient.CreateUser(ctx, desired))
+	}
+	return trace.Wrap(teleportClient.UpdateUser(ctx, desired))
+}
+
+func (w *DriftWatcher) handleTokenEvent(ctx context.Context, event types.Event) error {
+	k8sResource := &resourcesv2.TeleportProvisionToken{}
+	err := w.Client.Get(ctx, kclient.ObjectKey{Namespace: w.Namespace, Name: event.Resource.GetName()}, k8sResource)
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return trace.Wrap(err)
+	}
+	if skipDriftCheck(k8sResource) || isTokenRotationEnabled(k8sResource) {
+		return nil
+	}
+
+	desired := k8sResource.ToTeleport()
+	addDriftOriginLabel(desired)
+	diff := driftDiff(event, desired)
+
+	if err := w.updateDriftCondition(ctx, k8sResource, &k8sResource.Status.Conditions, diff); err != nil {
+		return trace.Wrap(err)
+	}
+	if diff == "" || !w.AutoRevert {
+		return nil
+	}
+	teleportClient, err := w.TeleportClientAccessor(ctx)
+	if err != nil {
+		return trace.Wrap(err)
+	}
+	return trace.Wrap(teleportClient.UpsertToken(ctx, desired))
+}
+
+// skipDriftCheck filters out the CRs the drift watcher must leave alone: paused
+// resources are frozen, resources targeting another TeleportConnection are
+// reconciled against another cluster than the watched one, and CRs already being
+// deleted race with their own finalizer.
+func skipDriftCheck(obj kclient.Object) bool {
+	if isPaused(obj) {
+		return true
+	}
+	if obj.GetAnnotations()[ConnectionAnnotation] != "" {
+		return true
+	}
+	return !obj.GetDeletionTimestamp().IsZero()
+}
+
+// addDriftOriginLabel adds the Kubernetes origin label the reconcilers set on
+// upsert, so the label itself does not register as drift.
+func addDriftOriginLabel(resource types.Resource) {
+	metadata := resource.GetMetadata()
+	if metadata.Labels == nil {
+		metadata.Labels = make(map[string]string)
+	}
+	metadata.Labels[types.OriginLabel] = types.OriginKubernetes
+	type metadataSetter interface{ SetMetadata(meta types.Metadata) }
+	resource.(metadataSetter).SetMetadata(metadata)
+}
+
+// driftDiff renders the drift carried by a Teleport event, an empty string means
+// the event matches the desired state.
+func driftDiff(event types.Event, desired types.Resource) string {
+	if event.Type == types.OpDelete {
+		return "the resource was deleted from Teleport"
+	}
+	if !resourceDrifted(event.Resource, desired) {
+		return ""
+	}
+	return resourceDiff(event.Resource, desired)
+}
+
+// updateDriftCondition sets the Drifted condition on a CR status, skipping the
+// update when the condition did not change. The operator's own upserts also show
+// up in the event stream and must not cause a status write on every resync.
+func (w *DriftWatcher) updateDriftCondition(ctx context.Context, obj kclient.Object, conditions *[]metav1.Condition, diff string) error {
+	log := ctrllog.Log.WithName("drift-watcher")
+
+	condition := getDriftedCondition(diff)
+	if existing := meta.FindStatusCondition(*conditions, ConditionTypeDrifted); existing != nil &&
+		existing.Status == condition.Status &&
+		existing.Reason == condition.Reason &&
+		existing.Message == condition.Message {
+		return nil
+	}
+	if diff != "" {
+		log.Info("detected out-of-band change", "name", obj.GetName())
+	}
+	meta.SetStatusCondition(conditions, condition)
+	return trace.Wrap(w.Client.Status().Update(ctx, obj))
+}
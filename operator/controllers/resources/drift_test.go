@@ -0,0 +1,72 @@
+/*
+Copyright 2022 Gravitational, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gravitational/teleport/api/types"
+	"github.com/gravitational/teleport/lib/auth"
+	resourcesv5 "github.com/gravitational/teleport/operator/apis/resources/v5"
+)
+
+// When a role owned by the operator is edited out-of-band, the drift watcher
+// sets the Drifted condition on the CR.
+func TestDriftWatcher(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	setup := setupKubernetesAndTeleport(t)
+
+	roleName := validRandomResourceName("role-")
+	k8sCreateDummyRole(ctx, t, setup.k8sClient, setup.namespace.Name, roleName)
+	fastEventually(t, func() bool {
+		_, err := setup.tClient.GetRole(ctx, roleName)
+		return err == nil
+	})
+
+	watcher := &DriftWatcher{
+		Client: setup.k8sClient,
+		TeleportClientAccessor: func(ctx context.Context) (auth.ClientI, error) {
+			return setup.tClient, nil
+		},
+		Namespace: setup.namespace.Name,
+	}
+	go func() {
+		_ = watcher.Start(ctx)
+	}()
+
+	// An out-of-band edit keeping the Kubernetes origin label, as tctl would do.
+	tRole, err := setup.tClient.GetRole(ctx, roleName)
+	require.NoError(t, err)
+	tRole.SetLogins(types.Allow, []string{"edited-out-of-band"})
+	require.NoError(t, setup.tClient.UpsertRole(ctx, tRole))
+
+	fastEventually(t, func() bool {
+		role := &resourcesv5.TeleportRole{}
+		if err := setup.k8sClient.Get(ctx, kclient.ObjectKey{Namespace: setup.namespace.Name, Name: roleName}, role); err != nil {
+			return false
+		}
+		condition := meta.FindStatusCondition(role.Status.Conditions, ConditionTypeDrifted)
+		return condition != nil && condition.Status == metav1.ConditionTrue && condition.Reason == ConditionReasonOutOfBandChange
+	})
+}
@@ -0,0 +1,155 @@
+/*
+Copyright 2022 Gravitational, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+	ctrllog "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/gravitational/teleport/api/types"
+	resourcesv2 "github.com/gravitational/teleport/operator/apis/resources/v2"
+	resourcesv5 "github.com/gravitational/teleport/operator/apis/resources/v5"
+	"github.com/gravitational/teleport/operator/sidecar"
+	"github.com/gravitational/trace"
+)
+
+// GarbageCollector periodically deletes operator-owned Teleport resources whose
+// Kubernetes resource no longer exists. Deletions are normally propagated by the
+// finalizer, but when a CR is deleted while the operator is down the finalizer
+// never runs and the Teleport resource is orphaned forever.
+// It implements manager.Runnable and manager.LeaderElectionRunnable so it can be
+// added to a controllerruntime.Manager and only runs on the elected leader.
+type GarbageCollector struct {
+	// Reader must be an uncached reader: the manager cache can be restricted to the
+	// watched namespace and would hide CRs owned by another operator.
+	Reader                 kclient.Reader
+	TeleportClientAccessor sidecar.ClientAccessor
+	// Interval is the time between two sweeps.
+	Interval time.Duration
+}
+
+func (gc *GarbageCollector) NeedLeaderElection() bool {
+	return true
+}
+
+func (gc *GarbageCollector) Start(ctx context.Context) error {
+	log := ctrllog.Log.WithName("garbage-collector")
+	ticker := time.NewTicker(gc.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := gc.sweep(ctx); err != nil {
+				log.Error(err, "failed to garbage collect orphaned Teleport resources")
+			}
+		}
+	}
+}
+
+// sweep lists the Teleport resources with the Kubernetes origin and deletes those
+// with no corresponding CR. Only non-singleton resources are swept, singletons
+// always exist in Teleport and deleting the CR resets them instead.
+func (gc *GarbageCollector) sweep(ctx context.Context) error {
+	teleportClient, err := gc.TeleportClientAccessor(ctx)
+	if err != nil {
+		return trace.Wrap(err)
+	}
+
+	roles, err := teleportClient.GetRoles(ctx)
+	if err != nil {
+		return trace.Wrap(err)
+	}
+	roleResources := make([]types.Resource, len(roles))
+	for i, role := range roles {
+		roleResources[i] = role
+	}
+	if err := gc.sweepKind(ctx, "role", &resourcesv5.TeleportRoleList{}, roleResources, teleportClient.DeleteRole); err != nil {
+		return trace.Wrap(err)
+	}
+
+	users, err := teleportClient.GetUsers(false)
+	if err != nil {
+		return trace.Wrap(err)
+	}
+	userResources := make([]types.Resource, len(users))
+	for i, user := range users {
+		userResources[i] = user
+	}
+	if err := gc.sweepKind(ctx, "user", &resourcesv2.TeleportUserList{}, userResources, teleportClient.DeleteUser); err != nil {
+		return trace.Wrap(err)
+	}
+
+	tokens, err := teleportClient.GetTokens(ctx)
+	if err != nil {
+		return trace.Wrap(err)
+	}
+	tokenResources := make([]types.Resource, len(tokens))
+	for i, token := range tokens {
+		tokenResources[i] = token
+	}
+	return trace.Wrap(gc.sweepKind(ctx, "token", &resourcesv2.TeleportProvisionTokenList{}, tokenResources, teleportClient.DeleteToken))
+}
+
+// sweepKind deletes the Teleport resources of a single kind that have the
+// Kubernetes origin but no CR with the same name. The CRs are listed
+// cluster-wide: an operator scoped to another namespace may manage resources
+// in the same Teleport cluster, and a namespace-scoped list would sweep them
+// as orphans.
+func (gc *GarbageCollector) sweepKind(ctx context.Context, kind string, list kclient.ObjectList, resources []types.Resource, deleteResource func(context.Context, string) error) error {
+	log := ctrllog.Log.WithName("garbage-collector")
+
+	if err := gc.Reader.List(ctx, list); err != nil {
+		return trace.Wrap(err)
+	}
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return trace.Wrap(err)
+	}
+	crNames := make(map[string]struct{}, len(items))
+	for _, item := range items {
+		obj := item.(kclient.Object)
+		crNames[obj.GetName()] = struct{}{}
+		// A rotated provision token is intentionally not named after its CR: the
+		// current random value is tracked in the status and must not be swept as
+		// an orphan while the CR exists.
+		if token, ok := obj.(*resourcesv2.TeleportProvisionToken); ok {
+			if current := token.Status.TeleportResourceName; current != "" {
+				crNames[current] = struct{}{}
+			}
+		}
+	}
+
+	for _, resource := range resources {
+		if !isResourceOriginKubernetes(resource) {
+			continue
+		}
+		if _, ok := crNames[resource.GetName()]; ok {
+			continue
+		}
+		log.Info("deleting orphaned Teleport resource", "kind", kind, "name", resource.GetName())
+		if err := deleteResource(ctx, resource.GetName()); err != nil && !trace.IsNotFound(err) {
+			return trace.Wrap(err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,176 @@
+/*
+Copyright 2022 Gravitational, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gravitational/trace"
+	"github.com/stretchr/testify/require"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gravitational/teleport/api/types"
+	"github.com/gravitational/teleport/lib/auth"
+	resourcesv2 "github.com/gravitational/teleport/operator/apis/resources/v2"
+	resourcesv5 "github.com/gravitational/teleport/operator/apis/resources/v5"
+)
+
+// When the garbage collector sweeps, the operator-owned Teleport resources with
+// no corresponding CR are deleted, while CR-backed and manually created
+// resources are kept.
+func TestGarbageCollection(t *testing.T) {
+	ctx := context.Background()
+	setup := setupKubernetesAndTeleport(t)
+
+	orphanedName := validRandomResourceName("role-")
+	backedName := validRandomResourceName("role-")
+	manualName := validRandomResourceName("role-")
+
+	// An orphaned operator-owned role: Kubernetes origin but no CR, as if the
+	// CR had been deleted while the operator was down.
+	teleportCreateDummyRole(ctx, t, orphanedName, setup.tClient)
+
+	// A role backed by a CR.
+	k8sCreateDummyRole(ctx, t, setup.k8sClient, setup.namespace.Name, backedName)
+	fastEventually(t, func() bool {
+		_, err := setup.tClient.GetRole(ctx, backedName)
+		return err == nil
+	})
+
+	// A role created out of band, without the Kubernetes origin.
+	manualRole, err := types.NewRole(manualName, types.RoleSpecV5{})
+	require.NoError(t, err)
+	require.NoError(t, setup.tClient.UpsertRole(ctx, manualRole))
+
+	gc := &GarbageCollector{
+		Reader: setup.k8sClient,
+		TeleportClientAccessor: func(ctx context.Context) (auth.ClientI, error) {
+			return setup.tClient, nil
+		},
+		Interval: time.Hour,
+	}
+	require.NoError(t, gc.sweep(ctx))
+
+	_, err = setup.tClient.GetRole(ctx, orphanedName)
+	require.True(t, trace.IsNotFound(err))
+	_, err = setup.tClient.GetRole(ctx, backedName)
+	require.NoError(t, err)
+	_, err = setup.tClient.GetRole(ctx, manualName)
+	require.NoError(t, err)
+}
+
+// A role kept with the keep-on-delete annotation is relabeled as dynamic when its
+// CR is deleted, so the garbage collector does not treat it as an orphan.
+func TestGarbageCollectionSkipsKeptResources(t *testing.T) {
+	ctx := context.Background()
+	setup := setupKubernetesAndTeleport(t)
+
+	keptName := validRandomResourceName("role-")
+
+	role := resourcesv5.TeleportRole{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        keptName,
+			Namespace:   setup.namespace.Name,
+			Annotations: map[string]string{KeepOnDeleteAnnotation: "true"},
+		},
+		Spec: resourcesv5.TeleportRoleSpec{
+			Allow: types.RoleConditions{
+				Logins: []string{"a", "b"},
+			},
+		},
+	}
+	k8sCreateRole(ctx, t, setup.k8sClient, &role)
+	fastEventually(t, func() bool {
+		_, err := setup.tClient.GetRole(ctx, keptName)
+		return err == nil
+	})
+
+	k8sDeleteRole(ctx, t, setup.k8sClient, keptName, setup.namespace.Name)
+	fastEventually(t, func() bool {
+		var r resourcesv5.TeleportRole
+		err := setup.k8sClient.Get(ctx, kclient.ObjectKey{Namespace: setup.namespace.Name, Name: keptName}, &r)
+		return kerrors.IsNotFound(err)
+	})
+
+	// Orphaning replaced the Kubernetes origin with the dynamic one.
+	tRole, err := setup.tClient.GetRole(ctx, keptName)
+	require.NoError(t, err)
+	require.Equal(t, types.OriginDynamic, tRole.GetMetadata().Labels[types.OriginLabel])
+
+	gc := &GarbageCollector{
+		Reader: setup.k8sClient,
+		TeleportClientAccessor: func(ctx context.Context) (auth.ClientI, error) {
+			return setup.tClient, nil
+		},
+		Interval: time.Hour,
+	}
+	require.NoError(t, gc.sweep(ctx))
+
+	_, err = setup.tClient.GetRole(ctx, keptName)
+	require.NoError(t, err)
+}
+
+// A rotated provision token is not named after its CR, the garbage collector must
+// recognize the current value through the CR status instead of sweeping it.
+func TestGarbageCollectionSkipsRotatedTokens(t *testing.T) {
+	ctx := context.Background()
+	setup := setupKubernetesAndTeleport(t)
+
+	tokenName := validRandomResourceName("token-")
+
+	token := resourcesv2.TeleportProvisionToken{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        tokenName,
+			Namespace:   setup.namespace.Name,
+			Annotations: map[string]string{RotateAnnotation: "true"},
+		},
+		Spec: resourcesv2.TeleportProvisionTokenSpec{
+			Roles: []types.SystemRole{types.RoleNode},
+		},
+	}
+	k8sCreateProvisionToken(ctx, t, setup.k8sClient, &token)
+
+	var tokenValue string
+	fastEventually(t, func() bool {
+		var k8sToken resourcesv2.TeleportProvisionToken
+		if err := setup.k8sClient.Get(ctx, kclient.ObjectKey{
+			Namespace: setup.namespace.Name,
+			Name:      tokenName,
+		}, &k8sToken); err != nil {
+			return false
+		}
+		tokenValue = k8sToken.Status.TeleportResourceName
+		return tokenValue != ""
+	})
+
+	gc := &GarbageCollector{
+		Reader: setup.k8sClient,
+		TeleportClientAccessor: func(ctx context.Context) (auth.ClientI, error) {
+			return setup.tClient, nil
+		},
+		Interval: time.Hour,
+	}
+	require.NoError(t, gc.sweep(ctx))
+
+	_, err := setup.tClient.GetToken(ctx, tokenValue)
+	require.NoError(t, err)
+}
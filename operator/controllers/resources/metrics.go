@@ -0,0 +1,91 @@
+/*
+Copyright 2022 Gravitational, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+var (
+	reconciliationsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "teleport_operator_reconciliations_total",
+			Help: "Total number of reconciliations per resource kind",
+		},
+		[]string{"kind"},
+	)
+	reconciliationErrorsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "teleport_operator_reconciliation_errors_total",
+			Help: "Total number of failed reconciliations per resource kind",
+		},
+		[]string{"kind"},
+	)
+	reconciliationLatencies = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name: "teleport_operator_reconciliation_seconds",
+			Help: "Reconciliation latency per resource kind",
+			// lowest bucket start of upper bound 0.001 sec (1 ms) with factor 2
+			// highest bucket start of 0.001 sec * 2^15 == 32.768 sec
+			Buckets: prometheus.ExponentialBuckets(0.001, 2, 16),
+		},
+		[]string{"kind"},
+	)
+	resourcesOutOfSync = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "teleport_operator_resources_out_of_sync",
+			Help: "Number of resources whose last reconciliation failed, per resource kind",
+		},
+		[]string{"kind"},
+	)
+)
+
+func init() {
+	metrics.Registry.MustRegister(
+		reconciliationsTotal,
+		reconciliationErrorsTotal,
+		reconciliationLatencies,
+		resourcesOutOfSync,
+	)
+}
+
+// outOfSyncTracker keeps the set of resources whose last reconciliation failed, so the
+// out-of-sync gauge reports a number of resources instead of a number of failure events.
+type outOfSyncTracker struct {
+	mutex     sync.Mutex
+	resources map[string]map[string]struct{}
+}
+
+var outOfSync = &outOfSyncTracker{resources: make(map[string]map[string]struct{})}
+
+// setOutOfSync records whether a resource is out of sync and updates the gauge accordingly.
+func (t *outOfSyncTracker) setOutOfSync(kind, name string, isOutOfSync bool) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	if t.resources[kind] == nil {
+		t.resources[kind] = make(map[string]struct{})
+	}
+	if isOutOfSync {
+		t.resources[kind][name] = struct{}{}
+	} else {
+		delete(t.resources[kind], name)
+	}
+	resourcesOutOfSync.WithLabelValues(kind).Set(float64(len(t.resources[kind])))
+}
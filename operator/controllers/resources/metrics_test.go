@@ -0,0 +1,50 @@
+/*
+Copyright 2022 Gravitational, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOutOfSyncTracker(t *testing.T) {
+	kind := "TestKind"
+	tracker := &outOfSyncTracker{resources: make(map[string]map[string]struct{})}
+	gaugeValue := func() float64 {
+		return testutil.ToFloat64(resourcesOutOfSync.WithLabelValues(kind))
+	}
+
+	// The gauge counts resources, not failure events
+	tracker.setOutOfSync(kind, "ns/a", true)
+	require.Equal(t, float64(1), gaugeValue())
+	tracker.setOutOfSync(kind, "ns/a", true)
+	require.Equal(t, float64(1), gaugeValue())
+	tracker.setOutOfSync(kind, "ns/b", true)
+	require.Equal(t, float64(2), gaugeValue())
+
+	// Resources going back in sync are removed from the gauge
+	tracker.setOutOfSync(kind, "ns/a", false)
+	require.Equal(t, float64(1), gaugeValue())
+	tracker.setOutOfSync(kind, "ns/b", false)
+	require.Equal(t, float64(0), gaugeValue())
+
+	// Resources that were never out of sync don't go negative
+	tracker.setOutOfSync(kind, "ns/c", false)
+	require.Equal(t, float64(0), gaugeValue())
+}
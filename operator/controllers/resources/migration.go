@@ -0,0 +1,134 @@
+/*
+Copyright 2022 Gravitational, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"context"
+	"time"
+
+	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+	ctrllog "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/gravitational/trace"
+)
+
+// teleportRoleCRDName is the name of the TeleportRole CustomResourceDefinition whose
+// stored versions are migrated.
+const teleportRoleCRDName = "teleportroles.resources.teleport.dev"
+
+// storageMigrationRetryPeriod is the time to wait before retrying a failed migration.
+// The first attempts can fail while the conversion webhook served by this very
+// operator is not reachable yet.
+const storageMigrationRetryPeriod = 30 * time.Second
+
+//+kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch
+//+kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions/status,verbs=get;update;patch
+
+// StorageVersionMigrator rewrites the TeleportRole CRs stored at an older API version
+// to the current storage version after an operator upgrade. Without it the etcd
+// objects keep their original version forever and the CRD accumulates stored
+// versions that can never be dropped, eventually blocking the removal of old
+// versions from the CRD.
+//
+// Each CR is read through the conversion webhook and written back unchanged, which
+// re-stores it at the storage version. Once every CR is rewritten the CRD status is
+// updated to only list the storage version. The stored versions are tracked on the
+// cluster-scoped CRD, so the CRs are listed cluster-wide regardless of the namespace
+// the operator watches.
+// It implements manager.Runnable and manager.LeaderElectionRunnable so it can be
+// added to a controllerruntime.Manager and only runs on the elected leader.
+type StorageVersionMigrator struct {
+	Client kclient.Client
+	// Reader must be an uncached reader: the manager cache can be restricted to the
+	// watched namespace and would hide CRs living in other namespaces.
+	Reader kclient.Reader
+}
+
+func (m *StorageVersionMigrator) NeedLeaderElection() bool {
+	return true
+}
+
+func (m *StorageVersionMigrator) Start(ctx context.Context) error {
+	log := ctrllog.Log.WithName("storage-migrator")
+	for {
+		err := m.migrate(ctx)
+		if err == nil {
+			return nil
+		}
+		log.Error(err, "failed to migrate the stored role CRs, retrying")
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(storageMigrationRetryPeriod):
+		}
+	}
+}
+
+// migrate rewrites the role CRs and shrinks the CRD stored versions to the current
+// storage version. It is a no-op when the storage version is the only stored one.
+func (m *StorageVersionMigrator) migrate(ctx context.Context) error {
+	log := ctrllog.Log.WithName("storage-migrator")
+
+	crd := &apiextv1.CustomResourceDefinition{}
+	if err := m.Client.Get(ctx, kclient.ObjectKey{Name: teleportRoleCRDName}, crd); err != nil {
+		return trace.Wrap(err)
+	}
+
+	var storageVersion string
+	for _, version := range crd.Spec.Versions {
+		if version.Storage {
+			storageVersion = version.Name
+			break
+		}
+	}
+	if storageVersion == "" {
+		return trace.NotFound("CRD %s has no storage version", teleportRoleCRDName)
+	}
+	if len(crd.Status.StoredVersions) == 1 && crd.Status.StoredVersions[0] == storageVersion {
+		return nil
+	}
+	log.Info("migrating stored role CRs", "storedVersions", crd.Status.StoredVersions, "storageVersion", storageVersion)
+
+	// Reading the list at the storage version goes through the conversion webhook,
+	// writing the items back re-stores them at the storage version. The list is
+	// cluster-wide: CRs in other namespaces must be rewritten too before the stored
+	// versions of the cluster-scoped CRD are shrunk.
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   teleportRoleGVK.Group,
+		Version: storageVersion,
+		Kind:    teleportRoleGVK.Kind + "List",
+	})
+	if err := m.Reader.List(ctx, list); err != nil {
+		return trace.Wrap(err)
+	}
+	for i := range list.Items {
+		if err := m.Client.Update(ctx, &list.Items[i]); err != nil {
+			return trace.Wrap(err)
+		}
+	}
+
+	crd.Status.StoredVersions = []string{storageVersion}
+	if err := m.Client.Status().Update(ctx, crd); err != nil {
+		return trace.Wrap(err)
+	}
+	log.Info("stored role CRs migrated", "count", len(list.Items), "storageVersion", storageVersion)
+	return nil
+}
@@ -0,0 +1,434 @@
+/*
+Copyright 2022 Gravitational, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gravitational/trace"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"github.com/gravitational/teleport/api/types"
+	"github.com/gravitational/teleport/lib/auth"
+	"github.com/gravitational/teleport/lib/utils"
+	resourcesv2 "github.com/gravitational/teleport/operator/apis/resources/v2"
+	"github.com/gravitational/teleport/operator/sidecar"
+)
+
+const teleportProvisionTokenKind = "TeleportProvisionToken"
+
+// RotateAnnotation enables the automatic rotation of a provision token while set to "true".
+// The operator generates random short-lived token values instead of using the CR name,
+// publishes the current value in a Secret and renews it before expiry.
+const RotateAnnotation = "teleport.dev/rotate"
+
+// TokenSecretNameAnnotation overrides the name of the Secret receiving the rotated token
+// value, it defaults to the CR name suffixed with "-token".
+const TokenSecretNameAnnotation = "teleport.dev/token-secret-name"
+
+// TokenTTLAnnotation overrides the lifetime of the rotated token values
+// (format: https://pkg.go.dev/time#ParseDuration).
+const TokenTTLAnnotation = "teleport.dev/token-ttl"
+
+const (
+	// defaultRotatedTokenTTL is the lifetime of a rotated token value when the
+	// token-ttl annotation is not set.
+	defaultRotatedTokenTTL = time.Hour
+	// rotatedTokenLenBytes is the amount of random bytes in a rotated token value.
+	rotatedTokenLenBytes = 16
+	// tokenSecretKey is the Secret data key holding the current token value.
+	tokenSecretKey = "token"
+)
+
+var teleportProvisionTokenGVK = schema.GroupVersionKind{
+	Group:   resourcesv2.GroupVersion.Group,
+	Version: resourcesv2.GroupVersion.Version,
+	Kind:    teleportProvisionTokenKind,
+}
+
+// ProvisionTokenReconciler reconciles a TeleportProvisionToken object
+type ProvisionTokenReconciler struct {
+	kclient.Client
+	Scheme                 *runtime.Scheme
+	TeleportClientAccessor sidecar.ClientAccessor
+	KeepResourcesOnDelete  bool
+	DryRun                 bool
+	Recorder               record.EventRecorder
+}
+
+//+kubebuilder:rbac:groups=resources.teleport.dev,resources=provisiontokens,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=resources.teleport.dev,resources=provisiontokens/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=resources.teleport.dev,resources=provisiontokens/finalizers,verbs=update
+//+kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch;create;update;patch
+
+// Reconcile is part of the main kubernetes reconciliation loop which aims to
+// move the current state of the cluster closer to the desired state.
+func (r *ProvisionTokenReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	// The TeleportProvisionToken OpenAPI spec does not validate typing of Label fields like `suggested_labels`.
+	// This means we can receive invalid data, by default it won't be unmarshalled properly and will crash the operator.
+	// To handle this more gracefully we unmarshall first in an unstructured object.
+	// The unstructured object will be converted later to a typed one, in r.UpsertExternal.
+	// See `/operator/crdgen/schemagen.go` and https://github.com/gravitational/teleport/issues/15204 for context.
+	obj := getUnstructuredObjectFromGVK(teleportProvisionTokenGVK)
+	result, err := ResourceBaseReconciler{
+		Client:                r.Client,
+		Kind:                  teleportProvisionTokenKind,
+		KeepResourcesOnDelete: r.KeepResourcesOnDelete,
+		DryRun:                r.DryRun,
+		Recorder:              r.Recorder,
+		DeleteExternal:        r.Delete,
+		UpsertExternal:        r.Upsert,
+		OrphanExternal:        r.Orphan,
+	}.Do(ctx, req, obj)
+	if err != nil {
+		return result, trace.Wrap(err)
+	}
+
+	// A rotated token must be renewed before it expires, well before the next
+	// manager resync. Requeue at the planned rotation time.
+	if requeueAfter := tokenRotationRequeueAfter(obj, time.Now()); requeueAfter > 0 {
+		result.RequeueAfter = requeueAfter
+	}
+	return result, nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *ProvisionTokenReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// The TeleportProvisionToken OpenAPI spec does not validate typing of Label fields like `suggested_labels`.
+	// This means we can receive invalid data, by default it won't be unmarshalled properly and will crash the operator
+	// To handle this more gracefully we unmarshall first in an unstructured object.
+	// The unstructured object will be converted later to a typed one, in r.UpsertExternal.
+	// See `/operator/crdgen/schemagen.go` and https://github.com/gravitational/teleport/issues/15204 for context
+	obj := getUnstructuredObjectFromGVK(teleportProvisionTokenGVK)
+	return ctrl.NewControllerManagedBy(mgr).
+		For(obj).
+		// The target Secrets of rotated tokens are owned by the CR, editing or
+		// deleting one triggers a reconciliation restoring the token value.
+		Owns(&corev1.Secret{}).
+		Complete(r)
+}
+
+func (r *ProvisionTokenReconciler) Delete(ctx context.Context, obj kclient.Object) error {
+	teleportClient, err := clientForResource(ctx, r.Client, obj, r.TeleportClientAccessor)
+	if err != nil {
+		return trace.Wrap(err)
+	}
+
+	// The target Secret of a rotated token is garbage collected through its owner reference.
+	name, err := tokenTeleportName(obj)
+	if err != nil {
+		return trace.Wrap(err)
+	}
+	if name == "" {
+		// No token value was ever issued, nothing to delete in Teleport.
+		return nil
+	}
+	return teleportClient.DeleteToken(ctx, name)
+}
+
+// Orphan relabels the Teleport token as no longer operator-owned when the CR is deleted with
+// orphaning requested, so the garbage collector does not sweep the kept token. For a rotated
+// token the current random value is the one relabeled.
+func (r *ProvisionTokenReconciler) Orphan(ctx context.Context, obj kclient.Object) error {
+	teleportClient, err := clientForResource(ctx, r.Client, obj, r.TeleportClientAccessor)
+	if err != nil {
+		return trace.Wrap(err)
+	}
+
+	name, err := tokenTeleportName(obj)
+	if err != nil {
+		return trace.Wrap(err)
+	}
+	if name == "" {
+		// No token value was ever issued, nothing to relabel in Teleport.
+		return nil
+	}
+	token, err := teleportClient.GetToken(ctx, name)
+	if err != nil {
+		return trace.Wrap(err)
+	}
+	if !isResourceOriginKubernetes(token) {
+		return nil
+	}
+	setResourceOriginDynamic(token)
+	return trace.Wrap(teleportClient.UpsertToken(ctx, token))
+}
+
+// tokenTeleportName returns the name of the Teleport token backing a CR. A rotated token is
+// not named after the CR, the current value is tracked in the status; an empty name means no
+// value was issued yet.
+func tokenTeleportName(obj kclient.Object) (string, error) {
+	if !isTokenRotationEnabled(obj) {
+		return obj.GetName(), nil
+	}
+	u, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return "", fmt.Errorf("failed to convert Object into resource object: %T", obj)
+	}
+	current, _, err := unstructured.NestedString(u.Object, "status", "teleportResourceName")
+	if err != nil {
+		return "", trace.Wrap(err)
+	}
+	return current, nil
+}
+
+func (r *ProvisionTokenReconciler) Upsert(ctx context.Context, obj kclient.Object) error {
+	// We receive an unstructured object. We convert it to a typed TeleportProvisionToken object and gracefully handle errors.
+	u, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("failed to convert Object into resource object: %T", obj)
+	}
+	k8sResource := &resourcesv2.TeleportProvisionToken{}
+
+	// If an error happens we want to put it in status.conditions before returning.
+	err := runtime.DefaultUnstructuredConverter.FromUnstructuredWithValidation(
+		u.Object,
+		k8sResource, true, /* returnUnknownFields */
+	)
+	newStructureCondition := getStructureConditionFromError(err)
+	meta.SetStatusCondition(&k8sResource.Status.Conditions, newStructureCondition)
+	if err != nil {
+		silentUpdateStatus(ctx, r.Client, k8sResource)
+		return trace.Wrap(err)
+	}
+
+	// A paused resource is frozen: the reconciliation stops here and nothing is
+	// propagated to Teleport until the annotation is removed.
+	if isPaused(k8sResource) {
+		meta.SetStatusCondition(&k8sResource.Status.Conditions, getPausedCondition(true))
+		return trace.Wrap(r.Status().Update(ctx, k8sResource))
+	}
+	meta.SetStatusCondition(&k8sResource.Status.Conditions, getPausedCondition(false))
+
+	// A rotation-enabled token does not map to a Teleport token named after the CR:
+	// the operator issues short-lived random token values, publishes the current one
+	// in the target Secret and renews it before expiry.
+	if isTokenRotationEnabled(k8sResource) {
+		teleportClient, err := clientForResource(ctx, r.Client, obj, r.TeleportClientAccessor)
+		if err != nil {
+			silentUpdateStatus(ctx, r.Client, k8sResource)
+			return trace.Wrap(err)
+		}
+		err = r.rotateToken(ctx, teleportClient, k8sResource)
+		newReconciliationCondition := getReconciliationConditionFromError(err)
+		meta.SetStatusCondition(&k8sResource.Status.Conditions, newReconciliationCondition)
+		if err != nil {
+			silentUpdateStatus(ctx, r.Client, k8sResource)
+			return trace.Wrap(err)
+		}
+		k8sResource.Status.ObservedGeneration = k8sResource.GetGeneration()
+		syncTime := metav1.Now()
+		k8sResource.Status.LastSyncTime = &syncTime
+		return trace.Wrap(r.Status().Update(ctx, k8sResource))
+	}
+
+	// Converting the Kubernetes resource into a Teleport one, checking potential ownership issues.
+	teleportResource := k8sResource.ToTeleport()
+	teleportClient, err := clientForResource(ctx, r.Client, obj, r.TeleportClientAccessor)
+	if err != nil {
+		silentUpdateStatus(ctx, r.Client, k8sResource)
+		return trace.Wrap(err)
+	}
+
+	existingResource, err := teleportClient.GetToken(ctx, teleportResource.GetName())
+	if err != nil && !trace.IsNotFound(err) {
+		silentUpdateStatus(ctx, r.Client, k8sResource)
+		return trace.Wrap(err)
+	}
+
+	// If an error happens we want to put it in status.conditions before returning.
+	newOwnershipCondition, err := checkOwnership(existingResource, isAdoptionRequested(k8sResource))
+	meta.SetStatusCondition(&k8sResource.Status.Conditions, newOwnershipCondition)
+	if err != nil {
+		silentUpdateStatus(ctx, r.Client, k8sResource)
+		return trace.Wrap(err)
+	}
+
+	r.addTeleportResourceOrigin(teleportResource)
+
+	// In dry-run mode the pending changes are reported in the conditions
+	// instead of being applied to Teleport.
+	if isDryRun(k8sResource, r.DryRun) {
+		var diff string
+		if resourceDrifted(existingResource, teleportResource) {
+			diff = resourceDiff(existingResource, teleportResource)
+		}
+		meta.SetStatusCondition(&k8sResource.Status.Conditions, getDryRunConditionFromDiff(diff))
+		return trace.Wrap(r.Status().Update(ctx, k8sResource))
+	}
+
+	// On periodic resync most resources did not drift, skipping the upsert
+	// avoids rewriting the Teleport backend on every sync period.
+	if !resourceDrifted(existingResource, teleportResource) {
+		k8sResource.Status.ObservedGeneration = k8sResource.GetGeneration()
+		k8sResource.Status.TeleportResourceName = teleportResource.GetName()
+		syncTime := metav1.Now()
+		k8sResource.Status.LastSyncTime = &syncTime
+		return trace.Wrap(r.Status().Update(ctx, k8sResource))
+	}
+
+	// If an error happens we want to put it in status.conditions before returning.
+	err = teleportClient.UpsertToken(ctx, teleportResource)
+	newReconciliationCondition := getReconciliationConditionFromError(err)
+	meta.SetStatusCondition(&k8sResource.Status.Conditions, newReconciliationCondition)
+	if err != nil {
+		silentUpdateStatus(ctx, r.Client, k8sResource)
+		return trace.Wrap(err)
+	}
+
+	k8sResource.Status.ObservedGeneration = k8sResource.GetGeneration()
+	k8sResource.Status.TeleportResourceName = teleportResource.GetName()
+	syncTime := metav1.Now()
+	k8sResource.Status.LastSyncTime = &syncTime
+
+	// We update the status conditions on exit
+	return trace.Wrap(r.Status().Update(ctx, k8sResource))
+}
+
+// rotateToken issues a new random token value when the current one is missing or due
+// for renewal, publishes it in the target Secret and deletes the replaced Teleport
+// token. The rotation timestamps are recorded in the status, the caller persists it.
+func (r *ProvisionTokenReconciler) rotateToken(ctx context.Context, teleportClient auth.ClientI, k8sResource *resourcesv2.TeleportProvisionToken) error {
+	ttl := defaultRotatedTokenTTL
+	if value, ok := k8sResource.GetAnnotations()[TokenTTLAnnotation]; ok {
+		parsed, err := time.ParseDuration(value)
+		if err != nil {
+			return trace.BadParameter("invalid %s annotation: %v", TokenTTLAnnotation, err)
+		}
+		ttl = parsed
+	}
+	secretName := tokenSecretName(k8sResource)
+
+	// Nothing to do when the current token value is still valid in Teleport,
+	// published in the Secret, and not due for renewal yet.
+	now := time.Now()
+	if next := k8sResource.Status.NextRotationTime; next != nil && now.Before(next.Time) {
+		if current := k8sResource.Status.TeleportResourceName; current != "" {
+			_, err := teleportClient.GetToken(ctx, current)
+			if err != nil && !trace.IsNotFound(err) {
+				return trace.Wrap(err)
+			}
+			if err == nil {
+				var secret corev1.Secret
+				secretErr := r.Get(ctx, kclient.ObjectKey{Namespace: k8sResource.GetNamespace(), Name: secretName}, &secret)
+				if secretErr == nil && string(secret.Data[tokenSecretKey]) == current {
+					return nil
+				}
+			}
+		}
+	}
+
+	tokenValue, err := utils.CryptoRandomHex(rotatedTokenLenBytes)
+	if err != nil {
+		return trace.Wrap(err)
+	}
+
+	teleportResource := k8sResource.ToTeleport()
+	teleportResource.SetName(tokenValue)
+	teleportResource.SetExpiry(now.Add(ttl))
+	r.addTeleportResourceOrigin(teleportResource)
+	if err := teleportClient.UpsertToken(ctx, teleportResource); err != nil {
+		return trace.Wrap(err)
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: k8sResource.GetNamespace(),
+			Name:      secretName,
+		},
+	}
+	if _, err := controllerutil.CreateOrUpdate(ctx, r.Client, secret, func() error {
+		secret.Data = map[string][]byte{tokenSecretKey: []byte(tokenValue)}
+		return controllerutil.SetControllerReference(k8sResource, secret, r.Scheme)
+	}); err != nil {
+		return trace.Wrap(err, "failed to publish the token value in the secret")
+	}
+
+	// The previous value is replaced, revoke it instead of letting it expire.
+	if previous := k8sResource.Status.TeleportResourceName; previous != "" && previous != tokenValue {
+		if err := teleportClient.DeleteToken(ctx, previous); err != nil && !trace.IsNotFound(err) {
+			return trace.Wrap(err)
+		}
+	}
+
+	rotationTime := metav1.NewTime(now)
+	nextRotationTime := metav1.NewTime(now.Add(ttl / 2))
+	k8sResource.Status.LastRotationTime = &rotationTime
+	k8sResource.Status.NextRotationTime = &nextRotationTime
+	k8sResource.Status.TeleportResourceName = tokenValue
+	return nil
+}
+
+// isTokenRotationEnabled reads the rotate annotation on a Kubernetes resource.
+func isTokenRotationEnabled(obj kclient.Object) bool {
+	return obj.GetAnnotations()[RotateAnnotation] == "true"
+}
+
+// tokenSecretName returns the name of the Secret receiving the rotated token value.
+func tokenSecretName(obj kclient.Object) string {
+	if name := obj.GetAnnotations()[TokenSecretNameAnnotation]; name != "" {
+		return name
+	}
+	return obj.GetName() + "-token"
+}
+
+// tokenRotationRequeueAfter returns when the reconciliation must run again to renew
+// the token, zero when rotation is disabled or no rotation is planned yet.
+func tokenRotationRequeueAfter(obj kclient.Object, now time.Time) time.Duration {
+	if !isTokenRotationEnabled(obj) {
+		return 0
+	}
+	u, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return 0
+	}
+	nextString, found, err := unstructured.NestedString(u.Object, "status", "nextRotationTime")
+	if err != nil || !found {
+		// The first rotation sets the status, the update event requeues the resource.
+		return 0
+	}
+	next, err := time.Parse(time.RFC3339, nextString)
+	if err != nil {
+		return 0
+	}
+	if requeueAfter := next.Sub(now); requeueAfter > 0 {
+		return requeueAfter
+	}
+	// The status read from the cache can lag behind the rotation that just
+	// happened, check again shortly instead of spinning.
+	return time.Minute
+}
+
+func (r *ProvisionTokenReconciler) addTeleportResourceOrigin(resource types.ProvisionToken) {
+	metadata := resource.GetMetadata()
+	if metadata.Labels == nil {
+		metadata.Labels = make(map[string]string)
+	}
+	metadata.Labels[types.OriginLabel] = types.OriginKubernetes
+	resource.SetMetadata(metadata)
+}
@@ -0,0 +1,494 @@
+/*
+Copyright 2022 Gravitational, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/gravitational/trace"
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/require"
+
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gravitational/teleport/api/types"
+	"github.com/gravitational/teleport/lib/auth"
+	resourcesv2 "github.com/gravitational/teleport/operator/apis/resources/v2"
+)
+
+// When I create or delete a TeleportProvisionToken CR in Kubernetes,
+// the corresponding join token must be created/deleted in Teleport.
+func TestProvisionTokenCreation(t *testing.T) {
+	ctx := context.Background()
+	setup := setupKubernetesAndTeleport(t)
+	tokenName := validRandomResourceName("token-")
+
+	// End of setup, we create the token in Kubernetes
+	k8sCreateDummyProvisionToken(ctx, t, setup.k8sClient, setup.namespace.Name, tokenName)
+
+	// We wait for the token to be created in Teleport
+	fastEventually(t, func() bool {
+		tToken, err := setup.tClient.GetToken(ctx, tokenName)
+		if trace.IsNotFound(err) {
+			return false
+		}
+		require.NoError(t, err)
+
+		// Token should have the same name, and have the Kubernetes origin label
+		require.Equal(t, tToken.GetName(), tokenName)
+		require.Contains(t, tToken.GetMetadata().Labels, types.OriginLabel)
+		require.Equal(t, tToken.GetMetadata().Labels[types.OriginLabel], types.OriginKubernetes)
+
+		return true
+	})
+
+	// Cleanup and setup, we delete the token in Kubernetes
+	k8sDeleteProvisionToken(ctx, t, setup.k8sClient, tokenName, setup.namespace.Name)
+
+	// We wait for the token to be deleted in Teleport
+	fastEventually(t, func() bool {
+		_, err := setup.tClient.GetToken(ctx, tokenName)
+		return trace.IsNotFound(err)
+	})
+}
+
+func TestProvisionTokenCreationFromYAML(t *testing.T) {
+	ctx := context.Background()
+	setup := setupKubernetesAndTeleport(t)
+	tests := []struct {
+		name          string
+		tokenSpecYAML string
+		shouldFail    bool
+		expectedSpec  *types.ProvisionTokenSpecV2
+	}{
+		{
+			name: "Valid node token",
+			tokenSpecYAML: `
+roles:
+- Node
+`,
+			shouldFail: false,
+			expectedSpec: &types.ProvisionTokenSpecV2{
+				Roles:      []types.SystemRole{types.RoleNode},
+				JoinMethod: types.JoinMethodToken,
+			},
+		},
+		{
+			name: "Valid IAM token with allow rules",
+			tokenSpecYAML: `
+roles:
+- Node
+join_method: iam
+allow:
+- aws_account: "333333333333"
+  aws_arn: "arn:aws:sts::333333333333:assumed-role/teleport-node-role/i-*"
+`,
+			shouldFail: false,
+			expectedSpec: &types.ProvisionTokenSpecV2{
+				Roles:      []types.SystemRole{types.RoleNode},
+				JoinMethod: types.JoinMethodIAM,
+				Allow: []*types.TokenRule{
+					{
+						AWSAccount: "333333333333",
+						AWSARN:     "arn:aws:sts::333333333333:assumed-role/teleport-node-role/i-*",
+					},
+				},
+			},
+		},
+		{
+			name: "Invalid suggested_labels (label value is integer)",
+			tokenSpecYAML: `
+roles:
+- Node
+suggested_labels:
+  'foo': 1
+`,
+			shouldFail:   true,
+			expectedSpec: nil,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			// Creating the Kubernetes resource. We are using an untyped client to be able to create invalid resources.
+			tokenManifest := map[string]interface{}{}
+			err := yaml.Unmarshal([]byte(tc.tokenSpecYAML), &tokenManifest)
+			require.NoError(t, err)
+
+			tokenName := validRandomResourceName("token-")
+
+			obj := getUnstructuredObjectFromGVK(teleportProvisionTokenGVK)
+			obj.Object["spec"] = tokenManifest
+			obj.SetName(tokenName)
+			obj.SetNamespace(setup.namespace.Name)
+			err = setup.k8sClient.Create(ctx, obj)
+			require.NoError(t, err)
+
+			// If failure is expected we should not see the resource in Teleport
+			if tc.shouldFail {
+				fastEventually(t, func() bool {
+					// We check status.Conditions was updated, this means the reconciliation happened
+					_ = setup.k8sClient.Get(ctx, kclient.ObjectKey{
+						Namespace: setup.namespace.Name,
+						Name:      tokenName,
+					}, obj)
+					errorConditions := getProvisionTokenStatusConditionError(obj.Object)
+					// If there's no error condition, reconciliation has not happened yet
+					if len(errorConditions) == 0 {
+						return false
+					}
+
+					_, err := setup.tClient.GetToken(ctx, tokenName)
+					require.True(t, trace.IsNotFound(err), "The token should not be created in Teleport")
+					return true
+				})
+			} else {
+				// We wait for Teleport resource creation
+				fastEventually(t, func() bool {
+					tToken, err := setup.tClient.GetToken(ctx, tokenName)
+					// If the resource creation should succeed we check the resource was found and validate ownership labels
+					if trace.IsNotFound(err) {
+						return false
+					}
+					require.NoError(t, err)
+
+					require.Equal(t, tToken.GetName(), tokenName)
+					require.Contains(t, tToken.GetMetadata().Labels, types.OriginLabel)
+					require.Equal(t, tToken.GetMetadata().Labels[types.OriginLabel], types.OriginKubernetes)
+					expectedToken := &types.ProvisionTokenV2{
+						Metadata: types.Metadata{Name: tokenName},
+						Spec:     *tc.expectedSpec,
+					}
+					require.NoError(t, expectedToken.CheckAndSetDefaults())
+					compareProvisionTokenSpecs(t, expectedToken, tToken)
+
+					return true
+				})
+			}
+			// Teardown
+
+			// The token is deleted in K8S
+			k8sDeleteProvisionToken(ctx, t, setup.k8sClient, tokenName, setup.namespace.Name)
+
+			// We wait for the token deletion in Teleport
+			fastEventually(t, func() bool {
+				_, err := setup.tClient.GetToken(ctx, tokenName)
+				return trace.IsNotFound(err)
+			})
+		})
+	}
+}
+
+func compareProvisionTokenSpecs(t *testing.T, expectedToken, actualToken types.ProvisionToken) {
+	expectedJSON, _ := json.Marshal(expectedToken)
+	expected := make(map[string]interface{})
+	_ = json.Unmarshal(expectedJSON, &expected)
+	actualJSON, _ := json.Marshal(actualToken)
+	actual := make(map[string]interface{})
+	_ = json.Unmarshal(actualJSON, &actual)
+
+	require.Equal(t, expected["spec"], actual["spec"])
+}
+
+func TestProvisionTokenDeletionDrift(t *testing.T) {
+	ctx := context.Background()
+	setup := setupKubernetesAndTeleport(t)
+	tokenName := validRandomResourceName("token-")
+
+	// The token is created in K8S
+	k8sCreateDummyProvisionToken(ctx, t, setup.k8sClient, setup.namespace.Name, tokenName)
+
+	fastEventually(t, func() bool {
+		tToken, err := setup.tClient.GetToken(ctx, tokenName)
+		if trace.IsNotFound(err) {
+			return false
+		}
+		require.NoError(t, err)
+
+		require.Equal(t, tToken.GetName(), tokenName)
+
+		require.Contains(t, tToken.GetMetadata().Labels, types.OriginLabel)
+		require.Equal(t, tToken.GetMetadata().Labels[types.OriginLabel], types.OriginKubernetes)
+
+		return true
+	})
+
+	err := setup.tClient.DeleteToken(ctx, tokenName)
+	require.NoError(t, err)
+	fastEventually(t, func() bool {
+		_, err := setup.tClient.GetToken(ctx, tokenName)
+		return trace.IsNotFound(err)
+	})
+
+	// The token is deleted in K8S
+	k8sDeleteProvisionToken(ctx, t, setup.k8sClient, tokenName, setup.namespace.Name)
+
+	var k8sToken resourcesv2.TeleportProvisionToken
+	fastEventually(t, func() bool {
+		err = setup.k8sClient.Get(ctx, kclient.ObjectKey{
+			Namespace: setup.namespace.Name,
+			Name:      tokenName,
+		}, &k8sToken)
+		return kerrors.IsNotFound(err)
+	})
+}
+
+func TestProvisionTokenUpdate(t *testing.T) {
+	ctx := context.Background()
+	setup := setupKubernetesAndTeleport(t)
+	tokenName := validRandomResourceName("token-")
+
+	// The token does not exist in K8S
+	var p resourcesv2.TeleportProvisionToken
+	err := setup.k8sClient.Get(ctx, kclient.ObjectKey{
+		Namespace: setup.namespace.Name,
+		Name:      tokenName,
+	}, &p)
+	require.True(t, kerrors.IsNotFound(err))
+
+	teleportCreateDummyProvisionToken(ctx, t, tokenName, setup.tClient)
+
+	// The token is created in K8S
+	k8sToken := resourcesv2.TeleportProvisionToken{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      tokenName,
+			Namespace: setup.namespace.Name,
+		},
+		Spec: resourcesv2.TeleportProvisionTokenSpec{
+			Roles: []types.SystemRole{types.RoleNode, types.RoleProxy},
+		},
+	}
+	k8sCreateProvisionToken(ctx, t, setup.k8sClient, &k8sToken)
+
+	// The token is updated in Teleport
+	fastEventually(t, func() bool {
+		tToken, err := setup.tClient.GetToken(ctx, tokenName)
+		require.NoError(t, err)
+
+		// TeleportProvisionToken updated with new roles
+		roles := tToken.GetRoles().StringSlice()
+		sort.Strings(roles)
+		return reflect.DeepEqual(roles, []string{"Node", "Proxy"})
+	})
+
+	// Updating the token in K8S
+	var k8sTokenNewVersion resourcesv2.TeleportProvisionToken
+	err = setup.k8sClient.Get(ctx, kclient.ObjectKey{
+		Namespace: setup.namespace.Name,
+		Name:      tokenName,
+	}, &k8sTokenNewVersion)
+	require.NoError(t, err)
+
+	k8sTokenNewVersion.Spec.Roles = append(k8sTokenNewVersion.Spec.Roles, types.RoleApp)
+	err = setup.k8sClient.Update(ctx, &k8sTokenNewVersion)
+	require.NoError(t, err)
+
+	// Updates the token in Teleport
+	fastEventually(t, func() bool {
+		tToken, err := setup.tClient.GetToken(ctx, tokenName)
+		require.NoError(t, err)
+
+		// TeleportProvisionToken updated with new roles
+		roles := tToken.GetRoles().StringSlice()
+		sort.Strings(roles)
+		return reflect.DeepEqual(roles, []string{"App", "Node", "Proxy"})
+	})
+}
+
+// When rotation is enabled on a TeleportProvisionToken CR, the operator issues a
+// random token value, publishes it in the target Secret and records the rotation
+// timestamps in the status. Deleting the CR revokes the current value.
+func TestProvisionTokenRotation(t *testing.T) {
+	ctx := context.Background()
+	setup := setupKubernetesAndTeleport(t)
+	tokenName := validRandomResourceName("token-")
+
+	token := resourcesv2.TeleportProvisionToken{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      tokenName,
+			Namespace: setup.namespace.Name,
+			Annotations: map[string]string{
+				RotateAnnotation:   "true",
+				TokenTTLAnnotation: "1h",
+			},
+		},
+		Spec: resourcesv2.TeleportProvisionTokenSpec{
+			Roles: []types.SystemRole{types.RoleNode},
+		},
+	}
+	k8sCreateProvisionToken(ctx, t, setup.k8sClient, &token)
+
+	// The operator publishes the issued token value in the target Secret
+	var tokenValue string
+	fastEventually(t, func() bool {
+		var secret corev1.Secret
+		if err := setup.k8sClient.Get(ctx, kclient.ObjectKey{
+			Namespace: setup.namespace.Name,
+			Name:      tokenName + "-token",
+		}, &secret); err != nil {
+			return false
+		}
+		tokenValue = string(secret.Data[tokenSecretKey])
+		return tokenValue != ""
+	})
+
+	// The published value exists in Teleport, expires, and has the Kubernetes origin
+	tToken, err := setup.tClient.GetToken(ctx, tokenValue)
+	require.NoError(t, err)
+	require.False(t, tToken.Expiry().IsZero())
+	require.Equal(t, types.OriginKubernetes, tToken.GetMetadata().Labels[types.OriginLabel])
+
+	// The rotation timestamps are recorded in the status
+	fastEventually(t, func() bool {
+		var k8sToken resourcesv2.TeleportProvisionToken
+		if err := setup.k8sClient.Get(ctx, kclient.ObjectKey{
+			Namespace: setup.namespace.Name,
+			Name:      tokenName,
+		}, &k8sToken); err != nil {
+			return false
+		}
+		return k8sToken.Status.LastRotationTime != nil &&
+			k8sToken.Status.NextRotationTime != nil &&
+			k8sToken.Status.TeleportResourceName == tokenValue
+	})
+
+	// Deleting the CR revokes the current token value
+	k8sDeleteProvisionToken(ctx, t, setup.k8sClient, tokenName, setup.namespace.Name)
+	fastEventually(t, func() bool {
+		_, err := setup.tClient.GetToken(ctx, tokenValue)
+		return trace.IsNotFound(err)
+	})
+}
+
+func k8sCreateDummyProvisionToken(ctx context.Context, t *testing.T, kc kclient.Client, namespace, tokenName string) {
+	token := resourcesv2.TeleportProvisionToken{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      tokenName,
+			Namespace: namespace,
+		},
+		Spec: resourcesv2.TeleportProvisionTokenSpec{
+			Roles: []types.SystemRole{types.RoleNode},
+		},
+	}
+	k8sCreateProvisionToken(ctx, t, kc, &token)
+}
+
+func k8sDeleteProvisionToken(ctx context.Context, t *testing.T, kc kclient.Client, tokenName, namespace string) {
+	token := resourcesv2.TeleportProvisionToken{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      tokenName,
+			Namespace: namespace,
+		},
+	}
+	err := kc.Delete(ctx, &token)
+	require.NoError(t, err)
+}
+
+func k8sCreateProvisionToken(ctx context.Context, t *testing.T, kc kclient.Client, token *resourcesv2.TeleportProvisionToken) {
+	err := kc.Create(ctx, token)
+	require.NoError(t, err)
+}
+
+func teleportCreateDummyProvisionToken(ctx context.Context, t *testing.T, tokenName string, tClient auth.ClientI) {
+	// The token is created in Teleport
+	tToken, err := types.NewProvisionTokenFromSpec(tokenName, time.Time{}, types.ProvisionTokenSpecV2{
+		Roles: []types.SystemRole{types.RoleNode},
+	})
+	require.NoError(t, err)
+	metadata := tToken.GetMetadata()
+	metadata.Labels = map[string]string{types.OriginLabel: types.OriginKubernetes}
+	tToken.SetMetadata(metadata)
+
+	err = tClient.UpsertToken(ctx, tToken)
+	require.NoError(t, err)
+}
+
+func TestAddTeleportResourceOriginProvisionToken(t *testing.T) {
+	r := ProvisionTokenReconciler{}
+	tests := []struct {
+		name     string
+		resource types.ProvisionToken
+	}{
+		{
+			name: "origin already set correctly",
+			resource: &types.ProvisionTokenV2{
+				Metadata: types.Metadata{
+					Name:   "token with correct origin",
+					Labels: map[string]string{types.OriginLabel: types.OriginKubernetes},
+				},
+			},
+		},
+		{
+			name: "origin already set incorrectly",
+			resource: &types.ProvisionTokenV2{
+				Metadata: types.Metadata{
+					Name:   "token with incorrect origin",
+					Labels: map[string]string{types.OriginLabel: types.OriginConfigFile},
+				},
+			},
+		},
+		{
+			name: "origin not set",
+			resource: &types.ProvisionTokenV2{
+				Metadata: types.Metadata{
+					Name:   "token without origin",
+					Labels: map[string]string{"foo": "bar"},
+				},
+			},
+		},
+		{
+			name: "no labels",
+			resource: &types.ProvisionTokenV2{
+				Metadata: types.Metadata{
+					Name: "token with no labels",
+				},
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r.addTeleportResourceOrigin(tc.resource)
+			metadata := tc.resource.GetMetadata()
+			require.Contains(t, metadata.Labels, types.OriginLabel)
+			require.Equal(t, metadata.Labels[types.OriginLabel], types.OriginKubernetes)
+		})
+	}
+}
+
+func getProvisionTokenStatusConditionError(object map[string]interface{}) []metav1.Condition {
+	var conditionsWithError []metav1.Condition
+	var status resourcesv2.TeleportProvisionTokenStatus
+	_ = mapstructure.Decode(object["status"], &status)
+
+	for _, condition := range status.Conditions {
+		if condition.Status == metav1.ConditionFalse {
+			conditionsWithError = append(conditionsWithError, condition)
+		}
+	}
+	return conditionsWithError
+}
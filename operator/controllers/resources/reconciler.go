@@ -18,8 +18,11 @@ package resources
 
 import (
 	"context"
+	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	kclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -37,8 +40,25 @@ type UpsertExternal func(context.Context, kclient.Object) error
 
 type ResourceBaseReconciler struct {
 	kclient.Client
+	// Kind is the Kubernetes kind of the reconciled resource, used to label the
+	// operator metrics. Metrics are not collected when the kind is empty.
+	Kind string
+	// KeepResourcesOnDelete is the operator-wide default for orphaning Teleport resources
+	// on deletion, it can be overridden per-resource with the keep-on-delete annotation.
+	KeepResourcesOnDelete bool
+	// DryRun is the operator-wide default for computing changes without applying them,
+	// it can be overridden per-resource with the dry-run annotation.
+	DryRun bool
+	// Recorder emits Kubernetes events describing the reconciliation outcome, so
+	// `kubectl describe` shows why a sync failed without reading the operator logs.
+	Recorder       record.EventRecorder
 	DeleteExternal DeleteExternal
 	UpsertExternal UpsertExternal
+	// OrphanExternal relabels the Teleport resource as no longer operator-owned when the
+	// Kubernetes resource is deleted with orphaning requested. Without it a kept resource
+	// retains the Kubernetes origin and the garbage collector deletes it as an orphan on
+	// the next sweep. May be nil for kinds the garbage collector does not sweep.
+	OrphanExternal DeleteExternal
 }
 
 /*
@@ -56,9 +76,15 @@ For deleting, the recommendation is to use finalizers.
 Finalizers allow us to map an external resource to a kubernetes resource.
 So, when we create or update a resource, we add our own finalizer to the kubernetes resource list of finalizers.
 
-For a delete event which has our finalizer: the resource is deleted in Teleport.
+For a delete event which has our finalizer: the resource is deleted in Teleport,
+unless orphaning was requested with the keep-on-delete annotation or the operator-wide
+default, in which case the Teleport resource is relabeled as no longer operator-owned
+and only the finalizer is removed.
 If it doesn't have the finalizer, we do nothing.
 
+A resource with the paused annotation is frozen: nothing is propagated to Teleport,
+including the deletion, until the annotation is removed.
+
 ----
 
 Every time we update a resource in Kubernetes (adding finalizers or the OriginLabel), we end the reconciliation process.
@@ -66,12 +92,47 @@ Afterwards, we receive the request again and we progress to the next step.
 This allow us to progress with smaller changes and avoid a long-running reconciliation.
 */
 func (r ResourceBaseReconciler) Do(ctx context.Context, req ctrl.Request, obj kclient.Object) (ctrl.Result, error) {
+	start := time.Now()
+	result, err := r.do(ctx, req, obj)
+	duration := time.Since(start)
+	if r.Kind != "" {
+		reconciliationsTotal.WithLabelValues(r.Kind).Inc()
+		reconciliationLatencies.WithLabelValues(r.Kind).Observe(duration.Seconds())
+		if err != nil {
+			reconciliationErrorsTotal.WithLabelValues(r.Kind).Inc()
+		}
+		outOfSync.setOutOfSync(r.Kind, req.NamespacedName.String(), err != nil)
+	}
+
+	// A single outcome log per reconciliation, with enough structure to filter a
+	// large fleet of CRs by kind, name, connection or outcome. The per-step logs
+	// are verbosity 1 and only show up when the log level is raised.
+	outcome := "success"
+	if err != nil {
+		outcome = "error"
+	}
+	connection := obj.GetAnnotations()[ConnectionAnnotation]
+	if connection == "" {
+		connection = "default"
+	}
+	ctrllog.FromContext(ctx).Info("reconciliation finished",
+		"kind", r.Kind,
+		"name", req.NamespacedName.String(),
+		"connection", connection,
+		"outcome", outcome,
+		"duration", duration.String(),
+	)
+	return result, err
+}
+
+// do implements the reconciliation logic, see Do for an overview.
+func (r ResourceBaseReconciler) do(ctx context.Context, req ctrl.Request, obj kclient.Object) (ctrl.Result, error) {
 	// https://sdk.operatorframework.io/docs/building-operators/golang/advanced-topics/#external-resources
 	log := ctrllog.FromContext(ctx).WithValues("namespacedname", req.NamespacedName)
 
 	if err := r.Get(ctx, req.NamespacedName, obj); err != nil {
 		if apierrors.IsNotFound(err) {
-			log.Info("not found")
+			log.V(1).Info("not found")
 			return ctrl.Result{}, nil
 		}
 		log.Error(err, "failed to get resource")
@@ -84,12 +145,35 @@ func (r ResourceBaseReconciler) Do(ctx context.Context, req ctrl.Request, obj kc
 	// Delete
 	if isMarkedToBeDeleted {
 		if hasDeletionFinalizer {
-			log.Info("deleting object in Teleport")
-			if err := r.DeleteExternal(ctx, obj); err != nil && !trace.IsNotFound(err) {
-				return ctrl.Result{}, trace.Wrap(err)
+			if isPaused(obj) {
+				// The deletion is not propagated and the finalizer stays until the
+				// resource is unpaused, the CR remains in the Terminating state.
+				log.V(1).Info("skipping deletion, reconciliation is paused")
+				r.recordEvent(obj, corev1.EventTypeNormal, "ReconciliationPaused", "Teleport resource deletion is suspended by the paused annotation")
+				return ctrl.Result{}, nil
+			}
+			if shouldKeepOnDelete(obj, r.KeepResourcesOnDelete) {
+				log.V(1).Info("keeping object in Teleport, orphaning was requested")
+				if r.OrphanExternal != nil && !isDryRun(obj, r.DryRun) {
+					if err := r.OrphanExternal(ctx, obj); err != nil && !trace.IsNotFound(err) {
+						r.recordEvent(obj, corev1.EventTypeWarning, "FailedToOrphanInTeleport", err.Error())
+						return ctrl.Result{}, trace.Wrap(err)
+					}
+				}
+				r.recordEvent(obj, corev1.EventTypeNormal, "KeptInTeleport", "Teleport resource was kept, orphaning was requested")
+			} else if isDryRun(obj, r.DryRun) {
+				log.V(1).Info("keeping object in Teleport, the operator is in dry-run mode")
+				r.recordEvent(obj, corev1.EventTypeNormal, "KeptInTeleport", "Teleport resource was kept, the operator is in dry-run mode")
+			} else {
+				log.V(1).Info("deleting object in Teleport")
+				if err := r.DeleteExternal(ctx, obj); err != nil && !trace.IsNotFound(err) {
+					r.recordEvent(obj, corev1.EventTypeWarning, "FailedToDeleteInTeleport", err.Error())
+					return ctrl.Result{}, trace.Wrap(err)
+				}
+				r.recordEvent(obj, corev1.EventTypeNormal, "DeletedInTeleport", "Teleport resource was deleted")
 			}
 
-			log.Info("removing finalizer")
+			log.V(1).Info("removing finalizer")
 			controllerutil.RemoveFinalizer(obj, DeletionFinalizer)
 			if err := r.Update(ctx, obj); err != nil {
 				return ctrl.Result{}, trace.Wrap(err, "failed to remove finalizer after deleting in teleport")
@@ -101,7 +185,7 @@ func (r ResourceBaseReconciler) Do(ctx context.Context, req ctrl.Request, obj kc
 	}
 
 	if !hasDeletionFinalizer {
-		log.Info("adding finalizer")
+		log.V(1).Info("adding finalizer")
 		controllerutil.AddFinalizer(obj, DeletionFinalizer)
 
 		err := r.Update(ctx, obj)
@@ -110,7 +194,21 @@ func (r ResourceBaseReconciler) Do(ctx context.Context, req ctrl.Request, obj kc
 	}
 
 	// Create or update
-	log.Info("upsert object in Teleport")
+	log.V(1).Info("upsert object in Teleport")
 	err := r.UpsertExternal(ctx, obj)
+	if err != nil {
+		r.recordEvent(obj, corev1.EventTypeWarning, "FailedToReconcileInTeleport", err.Error())
+	} else if !isDryRun(obj, r.DryRun) && !isPaused(obj) {
+		r.recordEvent(obj, corev1.EventTypeNormal, "ReconciledInTeleport", "Teleport resource was upserted")
+	}
 	return ctrl.Result{}, trace.Wrap(err)
 }
+
+// recordEvent emits a Kubernetes event for the reconciled object when an event
+// recorder is configured.
+func (r ResourceBaseReconciler) recordEvent(obj kclient.Object, eventType, reason, message string) {
+	if r.Recorder == nil {
+		return
+	}
+	r.Recorder.Event(obj, eventType, reason, message)
+}
@@ -18,13 +18,16 @@ package resources
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 
 	"github.com/gravitational/trace"
 	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	kclient "sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -46,6 +49,9 @@ type RoleReconciler struct {
 	kclient.Client
 	Scheme                 *runtime.Scheme
 	TeleportClientAccessor sidecar.ClientAccessor
+	KeepResourcesOnDelete  bool
+	DryRun                 bool
+	Recorder               record.EventRecorder
 }
 
 //+kubebuilder:rbac:groups=resources.teleport.dev,resources=roles,verbs=get;list;watch;create;update;patch;delete
@@ -69,9 +75,14 @@ func (r *RoleReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.
 	// See `/operator/crdgen/schemagen.go` and https://github.com/gravitational/teleport/issues/15204 for context.
 	obj := getUnstructuredObjectFromGVK(teleportRoleGVK)
 	return ResourceBaseReconciler{
-		Client:         r.Client,
-		DeleteExternal: r.Delete,
-		UpsertExternal: r.Upsert,
+		Client:                r.Client,
+		Kind:                  teleportRoleKind,
+		KeepResourcesOnDelete: r.KeepResourcesOnDelete,
+		DryRun:                r.DryRun,
+		Recorder:              r.Recorder,
+		DeleteExternal:        r.Delete,
+		UpsertExternal:        r.Upsert,
+		OrphanExternal:        r.Orphan,
 	}.Do(ctx, req, obj)
 }
 
@@ -89,13 +100,31 @@ func (r *RoleReconciler) SetupWithManager(mgr ctrl.Manager) error {
 }
 
 func (r *RoleReconciler) Delete(ctx context.Context, obj kclient.Object) error {
-	teleportClient, err := r.TeleportClientAccessor(ctx)
+	teleportClient, err := clientForResource(ctx, r.Client, obj, r.TeleportClientAccessor)
 	if err != nil {
 		return trace.Wrap(err)
 	}
 	return teleportClient.DeleteRole(ctx, obj.GetName())
 }
 
+// Orphan relabels the Teleport role as no longer operator-owned when the CR is deleted with
+// orphaning requested, so the garbage collector does not sweep the kept role.
+func (r *RoleReconciler) Orphan(ctx context.Context, obj kclient.Object) error {
+	teleportClient, err := clientForResource(ctx, r.Client, obj, r.TeleportClientAccessor)
+	if err != nil {
+		return trace.Wrap(err)
+	}
+	role, err := teleportClient.GetRole(ctx, obj.GetName())
+	if err != nil {
+		return trace.Wrap(err)
+	}
+	if !isResourceOriginKubernetes(role) {
+		return nil
+	}
+	setResourceOriginDynamic(role)
+	return trace.Wrap(teleportClient.UpsertRole(ctx, role))
+}
+
 func (r *RoleReconciler) Upsert(ctx context.Context, obj kclient.Object) error {
 	// We receive an unstructured object. We convert it to a typed TeleportRole object and gracefully handle errors.
 	u, ok := obj.(*unstructured.Unstructured)
@@ -104,11 +133,11 @@ func (r *RoleReconciler) Upsert(ctx context.Context, obj kclient.Object) error {
 	}
 	k8sResource := &resourcesv5.TeleportRole{}
 
+	// The decode goes through the Teleport JSON unmarshallers: label and trait values
+	// accept both the scalar and the list form, and an invalid value produces an error
+	// naming the offending field.
 	// If an error happens we want to put it in status.conditions before returning.
-	err := runtime.DefaultUnstructuredConverter.FromUnstructuredWithValidation(
-		u.Object,
-		k8sResource, true, /* returnUnknownFields */
-	)
+	err := unmarshalRole(u, k8sResource)
 	newStructureCondition := getStructureConditionFromError(err)
 	meta.SetStatusCondition(&k8sResource.Status.Conditions, newStructureCondition)
 	if err != nil {
@@ -116,9 +145,17 @@ func (r *RoleReconciler) Upsert(ctx context.Context, obj kclient.Object) error {
 		return trace.Wrap(err)
 	}
 
+	// A paused resource is frozen: the reconciliation stops here and nothing is
+	// propagated to Teleport until the annotation is removed.
+	if isPaused(k8sResource) {
+		meta.SetStatusCondition(&k8sResource.Status.Conditions, getPausedCondition(true))
+		return trace.Wrap(r.Status().Update(ctx, k8sResource))
+	}
+	meta.SetStatusCondition(&k8sResource.Status.Conditions, getPausedCondition(false))
+
 	// Converting the Kubernetes resource into a Teleport one, checking potential ownership issues.
 	teleportResource := k8sResource.ToTeleport()
-	teleportClient, err := r.TeleportClientAccessor(ctx)
+	teleportClient, err := clientForResource(ctx, r.Client, obj, r.TeleportClientAccessor)
 	if err != nil {
 		silentUpdateStatus(ctx, r.Client, k8sResource)
 		return trace.Wrap(err)
@@ -131,7 +168,7 @@ func (r *RoleReconciler) Upsert(ctx context.Context, obj kclient.Object) error {
 	}
 
 	// If an error happens we want to put it in status.conditions before returning.
-	newOwnershipCondition, err := checkOwnership(existingResource)
+	newOwnershipCondition, err := checkOwnership(existingResource, isAdoptionRequested(k8sResource))
 	meta.SetStatusCondition(&k8sResource.Status.Conditions, newOwnershipCondition)
 	if err != nil {
 		silentUpdateStatus(ctx, r.Client, k8sResource)
@@ -140,6 +177,27 @@ func (r *RoleReconciler) Upsert(ctx context.Context, obj kclient.Object) error {
 
 	r.addTeleportResourceOrigin(teleportResource)
 
+	// In dry-run mode the pending changes are reported in the conditions
+	// instead of being applied to Teleport.
+	if isDryRun(k8sResource, r.DryRun) {
+		var diff string
+		if resourceDrifted(existingResource, teleportResource) {
+			diff = resourceDiff(existingResource, teleportResource)
+		}
+		meta.SetStatusCondition(&k8sResource.Status.Conditions, getDryRunConditionFromDiff(diff))
+		return trace.Wrap(r.Status().Update(ctx, k8sResource))
+	}
+
+	// On periodic resync most resources did not drift, skipping the upsert
+	// avoids rewriting the Teleport backend on every sync period.
+	if !resourceDrifted(existingResource, teleportResource) {
+		k8sResource.Status.ObservedGeneration = k8sResource.GetGeneration()
+		k8sResource.Status.TeleportResourceName = teleportResource.GetName()
+		syncTime := metav1.Now()
+		k8sResource.Status.LastSyncTime = &syncTime
+		return trace.Wrap(r.Status().Update(ctx, k8sResource))
+	}
+
 	// If an error happens we want to put it in status.conditions before returning.
 	err = teleportClient.UpsertRole(ctx, teleportResource)
 	newReconciliationCondition := getReconciliationConditionFromError(err)
@@ -149,6 +207,11 @@ func (r *RoleReconciler) Upsert(ctx context.Context, obj kclient.Object) error {
 		return trace.Wrap(err)
 	}
 
+	k8sResource.Status.ObservedGeneration = k8sResource.GetGeneration()
+	k8sResource.Status.TeleportResourceName = teleportResource.GetName()
+	syncTime := metav1.Now()
+	k8sResource.Status.LastSyncTime = &syncTime
+
 	// We update the status conditions on exit
 	return trace.Wrap(r.Status().Update(ctx, k8sResource))
 }
@@ -162,6 +225,18 @@ func (r *RoleReconciler) addTeleportResourceOrigin(resource types.Role) {
 	resource.SetMetadata(metadata)
 }
 
+// unmarshalRole decodes the unstructured object received by the reconciler into a typed
+// TeleportRole. A plain JSON round-trip is used instead of the reflection-based
+// unstructured converter so the custom unmarshallers of the label and trait map types
+// are honored.
+func unmarshalRole(u *unstructured.Unstructured, role *resourcesv5.TeleportRole) error {
+	data, err := json.Marshal(u.Object)
+	if err != nil {
+		return trace.Wrap(err)
+	}
+	return trace.Wrap(json.Unmarshal(data, role))
+}
+
 func getUnstructuredObjectFromGVK(gvk schema.GroupVersionKind) *unstructured.Unstructured {
 	obj := unstructured.Unstructured{}
 	obj.SetGroupVersionKind(gvk)
@@ -33,6 +33,7 @@ import (
 	kclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/gravitational/teleport/api/types"
+	"github.com/gravitational/teleport/api/types/wrappers"
 	apiutils "github.com/gravitational/teleport/api/utils"
 	resourcesv5 "github.com/gravitational/teleport/operator/apis/resources/v5"
 )
@@ -129,6 +130,27 @@ allow:
 				},
 			},
 		},
+		{
+			name: "Valid request annotations (scalar and list forms)",
+			roleSpecYAML: `
+allow:
+  request:
+    annotations:
+      'foo': 'bar'
+      'env': ['dev', 'staging']
+`,
+			shouldFail: false,
+			expectedSpec: &types.RoleSpecV5{
+				Allow: types.RoleConditions{
+					Request: &types.AccessRequestConditions{
+						Annotations: wrappers.Traits{
+							"foo": {"bar"},
+							"env": {"dev", "staging"},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "Invalid node_labels (label value is integer)",
 			roleSpecYAML: `
@@ -155,6 +177,7 @@ allow:
 	}
 
 	for _, tc := range tests {
+		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
 			// Creating the Kubernetes resource. We are using an untyped client to be able to create invalid resources.
@@ -0,0 +1,136 @@
+/*
+Copyright 2022 Gravitational, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gravitational/teleport/api/types"
+	resourcesv2 "github.com/gravitational/teleport/operator/apis/resources/v2"
+)
+
+// When I create the TeleportSessionRecordingConfig CR in Kubernetes,
+// the session_recording_config must be updated in Teleport.
+// When I delete it, the Teleport defaults must be restored.
+func TestSessionRecordingConfigReconciliation(t *testing.T) {
+	ctx := context.Background()
+	setup := setupKubernetesAndTeleport(t)
+
+	// End of setup, we create the recording config in Kubernetes
+	recConfig := resourcesv2.TeleportSessionRecordingConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      types.MetaNameSessionRecordingConfig,
+			Namespace: setup.namespace.Name,
+		},
+		Spec: resourcesv2.TeleportSessionRecordingConfigSpec{
+			Mode:                types.RecordAtProxy,
+			ProxyChecksHostKeys: types.NewBoolOption(false),
+		},
+	}
+	err := setup.k8sClient.Create(ctx, &recConfig)
+	require.NoError(t, err)
+
+	// We wait for the recording config to be updated in Teleport
+	fastEventually(t, func() bool {
+		tRecConfig, err := setup.tClient.GetSessionRecordingConfig(ctx)
+		require.NoError(t, err)
+
+		if tRecConfig.Origin() != types.OriginKubernetes {
+			return false
+		}
+
+		require.Equal(t, tRecConfig.GetMode(), types.RecordAtProxy)
+		require.False(t, tRecConfig.GetProxyChecksHostKeys())
+
+		return true
+	})
+
+	// Cleanup and setup, we delete the recording config in Kubernetes
+	err = setup.k8sClient.Delete(ctx, &recConfig)
+	require.NoError(t, err)
+
+	// We wait for the Teleport defaults to be restored
+	fastEventually(t, func() bool {
+		tRecConfig, err := setup.tClient.GetSessionRecordingConfig(ctx)
+		require.NoError(t, err)
+		return tRecConfig.Origin() == types.OriginDefaults
+	})
+}
+
+// When I create a TeleportSessionRecordingConfig CR whose name is not the singleton name,
+// the controller must refuse to reconcile it and leave Teleport untouched.
+func TestSessionRecordingConfigConflictingInstance(t *testing.T) {
+	ctx := context.Background()
+	setup := setupKubernetesAndTeleport(t)
+	recConfigName := validRandomResourceName("recconfig-")
+
+	recConfig := resourcesv2.TeleportSessionRecordingConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      recConfigName,
+			Namespace: setup.namespace.Name,
+		},
+		Spec: resourcesv2.TeleportSessionRecordingConfigSpec{
+			Mode: types.RecordOff,
+		},
+	}
+	err := setup.k8sClient.Create(ctx, &recConfig)
+	require.NoError(t, err)
+
+	// We wait for the controller to reject the conflicting instance
+	fastEventually(t, func() bool {
+		var k8sRecConfig resourcesv2.TeleportSessionRecordingConfig
+		err := setup.k8sClient.Get(ctx, kclient.ObjectKey{
+			Namespace: setup.namespace.Name,
+			Name:      recConfigName,
+		}, &k8sRecConfig)
+		require.NoError(t, err)
+
+		for _, condition := range k8sRecConfig.Status.Conditions {
+			if condition.Type == ConditionTypeValidSingletonName {
+				require.Equal(t, condition.Status, metav1.ConditionFalse)
+				require.Equal(t, condition.Reason, ConditionReasonConflictingInstance)
+				return true
+			}
+		}
+		return false
+	})
+
+	// The Teleport recording config was not touched
+	tRecConfig, err := setup.tClient.GetSessionRecordingConfig(ctx)
+	require.NoError(t, err)
+	require.NotEqual(t, types.OriginKubernetes, tRecConfig.Origin())
+
+	// Teardown, the conflicting instance can still be deleted
+	err = setup.k8sClient.Delete(ctx, &recConfig)
+	require.NoError(t, err)
+
+	var k8sRecConfig resourcesv2.TeleportSessionRecordingConfig
+	fastEventually(t, func() bool {
+		err := setup.k8sClient.Get(ctx, kclient.ObjectKey{
+			Namespace: setup.namespace.Name,
+			Name:      recConfigName,
+		}, &k8sRecConfig)
+		return kerrors.IsNotFound(err)
+	})
+}
@@ -43,6 +43,7 @@ import (
 	"github.com/gravitational/teleport/api/types"
 	"github.com/gravitational/teleport/integration"
 	"github.com/gravitational/teleport/integration/helpers"
+	resourcesv1 "github.com/gravitational/teleport/operator/apis/resources/v1"
 	resourcesv2 "github.com/gravitational/teleport/operator/apis/resources/v2"
 	resourcesv5 "github.com/gravitational/teleport/operator/apis/resources/v5"
 	//+kubebuilder:scaffold:imports
@@ -99,6 +100,10 @@ func defaultTeleportServiceConfig(t *testing.T) (*helpers.TeleInstance, string)
 			Rules: []types.Rule{
 				types.NewRule("role", unrestricted),
 				types.NewRule("user", unrestricted),
+				types.NewRule("token", unrestricted),
+				types.NewRule("cluster_auth_preference", unrestricted),
+				types.NewRule("cluster_networking_config", unrestricted),
+				types.NewRule("session_recording_config", unrestricted),
 			},
 		},
 	})
@@ -129,6 +134,9 @@ func startKubernetesOperator(t *testing.T, teleportClient auth.ClientI) kclient.
 	err = resourcesv2.AddToScheme(scheme.Scheme)
 	require.NoError(t, err)
 
+	err = resourcesv1.AddToScheme(scheme.Scheme)
+	require.NoError(t, err)
+
 	k8sClient, err := kclient.New(cfg, kclient.Options{Scheme: scheme.Scheme})
 	require.NoError(t, err)
 	require.NotNil(t, k8sClient)
@@ -147,6 +155,7 @@ func startKubernetesOperator(t *testing.T, teleportClient auth.ClientI) kclient.
 		Client:                 k8sClient,
 		Scheme:                 k8sManager.GetScheme(),
 		TeleportClientAccessor: clientAccessor,
+		Recorder:               k8sManager.GetEventRecorderFor("teleport-operator"),
 	}).SetupWithManager(k8sManager)
 	require.NoError(t, err)
 
@@ -154,6 +163,45 @@ func startKubernetesOperator(t *testing.T, teleportClient auth.ClientI) kclient.
 		Client:                 k8sClient,
 		Scheme:                 k8sManager.GetScheme(),
 		TeleportClientAccessor: clientAccessor,
+		Recorder:               k8sManager.GetEventRecorderFor("teleport-operator"),
+	}).SetupWithManager(k8sManager)
+	require.NoError(t, err)
+
+	err = (&ProvisionTokenReconciler{
+		Client:                 k8sClient,
+		Scheme:                 k8sManager.GetScheme(),
+		TeleportClientAccessor: clientAccessor,
+		Recorder:               k8sManager.GetEventRecorderFor("teleport-operator"),
+	}).SetupWithManager(k8sManager)
+	require.NoError(t, err)
+
+	err = (&AuthPreferenceReconciler{
+		Client:                 k8sClient,
+		Scheme:                 k8sManager.GetScheme(),
+		TeleportClientAccessor: clientAccessor,
+		Recorder:               k8sManager.GetEventRecorderFor("teleport-operator"),
+	}).SetupWithManager(k8sManager)
+	require.NoError(t, err)
+
+	err = (&ClusterNetworkingConfigReconciler{
+		Client:                 k8sClient,
+		Scheme:                 k8sManager.GetScheme(),
+		TeleportClientAccessor: clientAccessor,
+		Recorder:               k8sManager.GetEventRecorderFor("teleport-operator"),
+	}).SetupWithManager(k8sManager)
+	require.NoError(t, err)
+
+	err = (&SessionRecordingConfigReconciler{
+		Client:                 k8sClient,
+		Scheme:                 k8sManager.GetScheme(),
+		TeleportClientAccessor: clientAccessor,
+		Recorder:               k8sManager.GetEventRecorderFor("teleport-operator"),
+	}).SetupWithManager(k8sManager)
+	require.NoError(t, err)
+
+	err = (&ConnectionReconciler{
+		Client: k8sClient,
+		Scheme: k8sManager.GetScheme(),
 	}).SetupWithManager(k8sManager)
 	require.NoError(t, err)
 
@@ -22,7 +22,9 @@ import (
 
 	"github.com/gravitational/trace"
 	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	kclient "sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -36,6 +38,9 @@ type UserReconciler struct {
 	kclient.Client
 	Scheme                 *runtime.Scheme
 	TeleportClientAccessor sidecar.ClientAccessor
+	KeepResourcesOnDelete  bool
+	DryRun                 bool
+	Recorder               record.EventRecorder
 }
 
 //+kubebuilder:rbac:groups=resources.teleport.dev,resources=users,verbs=get;list;watch;create;update;patch;delete
@@ -53,9 +58,14 @@ type UserReconciler struct {
 // - https://pkg.go.dev/sigs.k8s.io/controller-runtime@v0.11.0/pkg/reconcile
 func (r *UserReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	return ResourceBaseReconciler{
-		Client:         r.Client,
-		DeleteExternal: r.Delete,
-		UpsertExternal: r.Upsert,
+		Client:                r.Client,
+		Kind:                  "TeleportUser",
+		KeepResourcesOnDelete: r.KeepResourcesOnDelete,
+		DryRun:                r.DryRun,
+		Recorder:              r.Recorder,
+		DeleteExternal:        r.Delete,
+		UpsertExternal:        r.Upsert,
+		OrphanExternal:        r.Orphan,
 	}.Do(ctx, req, &resourcesv2.TeleportUser{})
 }
 
@@ -67,21 +77,48 @@ func (r *UserReconciler) SetupWithManager(mgr ctrl.Manager) error {
 }
 
 func (r *UserReconciler) Delete(ctx context.Context, obj kclient.Object) error {
-	teleportClient, err := r.TeleportClientAccessor(ctx)
+	teleportClient, err := clientForResource(ctx, r.Client, obj, r.TeleportClientAccessor)
 	if err != nil {
 		return trace.Wrap(err)
 	}
 	return teleportClient.DeleteUser(ctx, obj.GetName())
 }
 
+// Orphan relabels the Teleport user as no longer operator-owned when the CR is deleted with
+// orphaning requested, so the garbage collector does not sweep the kept user.
+func (r *UserReconciler) Orphan(ctx context.Context, obj kclient.Object) error {
+	teleportClient, err := clientForResource(ctx, r.Client, obj, r.TeleportClientAccessor)
+	if err != nil {
+		return trace.Wrap(err)
+	}
+	user, err := teleportClient.GetUser(obj.GetName(), false)
+	if err != nil {
+		return trace.Wrap(err)
+	}
+	if !isResourceOriginKubernetes(user) {
+		return nil
+	}
+	setResourceOriginDynamic(user)
+	return trace.Wrap(teleportClient.UpdateUser(ctx, user))
+}
+
 func (r *UserReconciler) Upsert(ctx context.Context, obj kclient.Object) error {
 	k8sResource, ok := obj.(*resourcesv2.TeleportUser)
 	if !ok {
 		return fmt.Errorf("failed to convert Object into resource object: %T", obj)
 	}
+
+	// A paused resource is frozen: the reconciliation stops here and nothing is
+	// propagated to Teleport until the annotation is removed.
+	if isPaused(k8sResource) {
+		meta.SetStatusCondition(&k8sResource.Status.Conditions, getPausedCondition(true))
+		return trace.Wrap(r.Status().Update(ctx, k8sResource))
+	}
+	meta.SetStatusCondition(&k8sResource.Status.Conditions, getPausedCondition(false))
+
 	teleportResource := k8sResource.ToTeleport()
 
-	teleportClient, err := r.TeleportClientAccessor(ctx)
+	teleportClient, err := clientForResource(ctx, r.Client, obj, r.TeleportClientAccessor)
 	if err != nil {
 		return trace.Wrap(err)
 	}
@@ -93,7 +130,7 @@ func (r *UserReconciler) Upsert(ctx context.Context, obj kclient.Object) error {
 
 	exists := !trace.IsNotFound(err)
 
-	newCondition, err := checkOwnership(existingResource)
+	newCondition, err := checkOwnership(existingResource, isAdoptionRequested(k8sResource))
 	// Setting the condition before returning a potential ownership error
 	meta.SetStatusCondition(&k8sResource.Status.Conditions, newCondition)
 	if err != nil {
@@ -103,6 +140,27 @@ func (r *UserReconciler) Upsert(ctx context.Context, obj kclient.Object) error {
 
 	r.addTeleportResourceOrigin(teleportResource)
 
+	// In dry-run mode the pending changes are reported in the conditions
+	// instead of being applied to Teleport.
+	if isDryRun(k8sResource, r.DryRun) {
+		var diff string
+		if resourceDrifted(existingResource, teleportResource) {
+			diff = resourceDiff(existingResource, teleportResource)
+		}
+		meta.SetStatusCondition(&k8sResource.Status.Conditions, getDryRunConditionFromDiff(diff))
+		return trace.Wrap(r.Status().Update(ctx, k8sResource))
+	}
+
+	// On periodic resync most resources did not drift, skipping the update
+	// avoids rewriting the Teleport backend on every sync period.
+	if exists && !resourceDrifted(existingResource, teleportResource) {
+		k8sResource.Status.ObservedGeneration = k8sResource.GetGeneration()
+		k8sResource.Status.TeleportResourceName = teleportResource.GetName()
+		syncTime := metav1.Now()
+		k8sResource.Status.LastSyncTime = &syncTime
+		return trace.Wrap(r.Status().Update(ctx, k8sResource))
+	}
+
 	if !exists {
 		err = teleportClient.CreateUser(ctx, teleportResource)
 	} else {
@@ -116,6 +174,11 @@ func (r *UserReconciler) Upsert(ctx context.Context, obj kclient.Object) error {
 		return trace.Wrap(err)
 	}
 
+	k8sResource.Status.ObservedGeneration = k8sResource.GetGeneration()
+	k8sResource.Status.TeleportResourceName = teleportResource.GetName()
+	syncTime := metav1.Now()
+	k8sResource.Status.LastSyncTime = &syncTime
+
 	// We update the status conditions on exit
 	return trace.Wrap(r.Status().Update(ctx, k8sResource))
 }
@@ -20,6 +20,8 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	kclient "sigs.k8s.io/controller-runtime/pkg/client"
 	ctrllog "sigs.k8s.io/controller-runtime/pkg/log"
@@ -35,11 +37,71 @@ const (
 	ConditionReasonNewResource            = "NewResource"
 	ConditionReasonNoError                = "NoError"
 	ConditionReasonTeleportError          = "TeleportError"
+	ConditionReasonNameMatching           = "NameMatching"
+	ConditionReasonInSync                 = "InSync"
+	ConditionReasonPendingChanges         = "PendingChanges"
+	ConditionReasonConflictingInstance    = "ConflictingInstance"
+	ConditionReasonResourceAdopted        = "ResourceAdopted"
+	ConditionReasonPausedAnnotationSet    = "PausedAnnotationSet"
+	ConditionReasonReconciliationActive   = "ReconciliationActive"
+	ConditionReasonOutOfBandChange        = "OutOfBandChange"
+	ConditionTypeConnectionEstablished    = "ConnectionEstablished"
 	ConditionTypeTeleportResourceOwned    = "TeleportResourceOwned"
 	ConditionTypeSuccessfullyReconciled   = "SuccessfullyReconciled"
 	ConditionTypeValidStructure           = "ValidStructure"
+	ConditionTypeValidSingletonName       = "ValidSingletonName"
+	ConditionTypePendingChanges           = "PendingChanges"
+	ConditionTypePaused                   = "Paused"
+	ConditionTypeDrifted                  = "Drifted"
 )
 
+// AdoptAnnotation marks a Kubernetes resource as allowed to take ownership of a pre-existing
+// Teleport resource with the same name. Without it the operator refuses to reconcile over a
+// resource that does not have the Kubernetes origin label.
+const AdoptAnnotation = "teleport.dev/adopt"
+
+// KeepOnDeleteAnnotation requests the Teleport resource to be kept when the Kubernetes
+// resource is deleted. Only the finalizer is removed, the Teleport resource is orphaned.
+const KeepOnDeleteAnnotation = "teleport.dev/keep-on-delete"
+
+// DryRunAnnotation requests the changes to be computed and reported in the status conditions
+// without being applied to Teleport. The annotation takes precedence over the operator-wide
+// default set by the -dry-run flag.
+const DryRunAnnotation = "teleport.dev/dry-run"
+
+// PausedAnnotation suspends the reconciliation of a Kubernetes resource while set to "true".
+// No change is propagated to Teleport, including the deletion, until the annotation is removed.
+const PausedAnnotation = "teleport.dev/paused"
+
+// isPaused reads the paused annotation on a Kubernetes resource.
+func isPaused(obj kclient.Object) bool {
+	return obj.GetAnnotations()[PausedAnnotation] == "true"
+}
+
+// isDryRun decides whether a Kubernetes resource must be reconciled without writing to
+// Teleport. The dry-run annotation takes precedence over the operator-wide default.
+func isDryRun(obj kclient.Object, defaultDryRun bool) bool {
+	if value, ok := obj.GetAnnotations()[DryRunAnnotation]; ok {
+		return value == "true"
+	}
+	return defaultDryRun
+}
+
+// isAdoptionRequested reads the adopt annotation on a Kubernetes resource.
+func isAdoptionRequested(obj kclient.Object) bool {
+	return obj.GetAnnotations()[AdoptAnnotation] == "true"
+}
+
+// shouldKeepOnDelete decides whether the Teleport resource must be kept when its Kubernetes
+// resource is deleted. The keep-on-delete annotation takes precedence over the operator-wide
+// default set by the -keep-resources-on-delete flag.
+func shouldKeepOnDelete(obj kclient.Object, defaultKeep bool) bool {
+	if value, ok := obj.GetAnnotations()[KeepOnDeleteAnnotation]; ok {
+		return value == "true"
+	}
+	return defaultKeep
+}
+
 // isResourceOriginKubernetes reads a teleport resource metadata, searches for the origin label and checks its
 // value is kubernetes.
 func isResourceOriginKubernetes(resource types.Resource) bool {
@@ -47,9 +109,23 @@ func isResourceOriginKubernetes(resource types.Resource) bool {
 	return label == types.OriginKubernetes
 }
 
+// setResourceOriginDynamic replaces the Kubernetes origin label with the dynamic one, marking a
+// kept resource as no longer operator-owned so the garbage collector leaves it alone.
+func setResourceOriginDynamic(resource types.Resource) {
+	metadata := resource.GetMetadata()
+	if metadata.Labels == nil {
+		metadata.Labels = make(map[string]string)
+	}
+	metadata.Labels[types.OriginLabel] = types.OriginDynamic
+	type metadataSetter interface{ SetMetadata(meta types.Metadata) }
+	resource.(metadataSetter).SetMetadata(metadata)
+}
+
 // checkOwnership takes an existing resource and validates the operator owns it.
+// When adoption is requested the operator takes ownership of an unowned resource instead of
+// bailing out, the origin label is set by the upsert that follows.
 // It returns an ownership condition and an error if the resource is not owned by the operator
-func checkOwnership(existingResource types.Resource) (metav1.Condition, error) {
+func checkOwnership(existingResource types.Resource, adopt bool) (metav1.Condition, error) {
 	if existingResource == nil {
 		condition := metav1.Condition{
 			Type:    ConditionTypeTeleportResourceOwned,
@@ -60,6 +136,15 @@ func checkOwnership(existingResource types.Resource) (metav1.Condition, error) {
 		return condition, nil
 	}
 	if !isResourceOriginKubernetes(existingResource) {
+		if adopt {
+			condition := metav1.Condition{
+				Type:    ConditionTypeTeleportResourceOwned,
+				Status:  metav1.ConditionTrue,
+				Reason:  ConditionReasonResourceAdopted,
+				Message: "A resource with the same name already exists in Teleport and the adopt annotation is set. Taking ownership of the existing resource.",
+			}
+			return condition, nil
+		}
 		// Existing Teleport resource does not belong to us, bailing out
 
 		condition := metav1.Condition{
@@ -104,6 +189,26 @@ func getReconciliationConditionFromError(err error) metav1.Condition {
 	return condition
 }
 
+// getConnectionConditionFromError takes an error returned by a connectivity probe and returns a
+// metav1.Condition describing whether the Teleport cluster behind a TeleportConnection is
+// reachable with the referenced credentials.
+func getConnectionConditionFromError(err error) metav1.Condition {
+	if err != nil {
+		return metav1.Condition{
+			Type:    ConditionTypeConnectionEstablished,
+			Status:  metav1.ConditionFalse,
+			Reason:  ConditionReasonTeleportError,
+			Message: fmt.Sprintf("Failed to connect to the Teleport cluster: %s", err),
+		}
+	}
+	return metav1.Condition{
+		Type:    ConditionTypeConnectionEstablished,
+		Status:  metav1.ConditionTrue,
+		Reason:  ConditionReasonNoError,
+		Message: "Successfully connected to the Teleport cluster.",
+	}
+}
+
 // getStructureConditionFromError takes a conversion error from k8s apimachinery's runtime.UnstructuredConverter
 // and returns a metav1.Condition describing how the status conversion went. This is used to provide feedback to
 // the user about the controller's ability to reconcile the resource.
@@ -124,6 +229,167 @@ func getStructureConditionFromError(err error) metav1.Condition {
 	}
 }
 
+// checkSingletonOwnership validates the operator is allowed to manage an existing singleton
+// resource, such as the cluster auth preferences or the cluster networking config.
+// Unlike regular resources singletons always exist: a fresh cluster holds resources with the
+// "defaults" origin, which the operator can take over. Resources coming from the Teleport
+// configuration file stay owned by the configuration file.
+func checkSingletonOwnership(existingResource types.ResourceWithOrigin) (metav1.Condition, error) {
+	switch origin := existingResource.Origin(); origin {
+	case types.OriginKubernetes:
+		condition := metav1.Condition{
+			Type:    ConditionTypeTeleportResourceOwned,
+			Status:  metav1.ConditionTrue,
+			Reason:  ConditionReasonOriginLabelMatching,
+			Message: "Teleport resource has the Kubernetes origin label.",
+		}
+		return condition, nil
+	case types.OriginDefaults, "":
+		condition := metav1.Condition{
+			Type:    ConditionTypeTeleportResourceOwned,
+			Status:  metav1.ConditionTrue,
+			Reason:  ConditionReasonNewResource,
+			Message: "The Teleport resource is still the default one. The reconciled resource is owned by the operator.",
+		}
+		return condition, nil
+	default:
+		// The resource comes from elsewhere (e.g. the auth service configuration file), bailing out
+		condition := metav1.Condition{
+			Type:    ConditionTypeTeleportResourceOwned,
+			Status:  metav1.ConditionFalse,
+			Reason:  ConditionReasonOriginLabelNotMatching,
+			Message: fmt.Sprintf("The Teleport resource already exists with the %q origin and does not have the Kubernetes origin label. Refusing to reconcile.", origin),
+		}
+		return condition, trace.AlreadyExists("unowned resource with origin %q already exists", origin)
+	}
+}
+
+// checkSingletonName validates a Kubernetes resource mapping to a singleton Teleport resource
+// is named after the singleton. Accepting arbitrarily-named instances would let several
+// Kubernetes resources conflict over the same Teleport resource.
+// It returns a singleton condition and an error if the name does not match.
+func checkSingletonName(name, singletonName string) (metav1.Condition, error) {
+	if name != singletonName {
+		condition := metav1.Condition{
+			Type:    ConditionTypeValidSingletonName,
+			Status:  metav1.ConditionFalse,
+			Reason:  ConditionReasonConflictingInstance,
+			Message: fmt.Sprintf("The Teleport resource is a singleton, the Kubernetes resource must be named %q. Refusing to reconcile a conflicting instance.", singletonName),
+		}
+		return condition, trace.BadParameter("resource must be named %q, got %q", singletonName, name)
+	}
+	condition := metav1.Condition{
+		Type:    ConditionTypeValidSingletonName,
+		Status:  metav1.ConditionTrue,
+		Reason:  ConditionReasonNameMatching,
+		Message: "Kubernetes resource is named after the Teleport singleton resource.",
+	}
+	return condition, nil
+}
+
+// resourceDrifted compares an existing Teleport resource with the resource built from the
+// Kubernetes one. Defaults are applied to the desired resource before comparing, so fields
+// set server-side on the existing resource don't register as drift. This allows the periodic
+// resync (controlled by the manager sync period) to restore out-of-band edits without
+// rewriting the Teleport backend when nothing changed.
+func resourceDrifted(existingResource, desiredResource types.Resource) bool {
+	if existingResource == nil {
+		return true
+	}
+	type defaulter interface{ CheckAndSetDefaults() error }
+	if resource, ok := desiredResource.(defaulter); ok {
+		if err := resource.CheckAndSetDefaults(); err != nil {
+			// The upsert will report the error in the status conditions.
+			return true
+		}
+	}
+	return !cmp.Equal(existingResource, desiredResource, compareOptions()...)
+}
+
+// compareOptions returns the comparison rules shared by resourceDrifted and resourceDiff:
+// backend-generated and server-managed fields never count as differences.
+func compareOptions() []cmp.Option {
+	return []cmp.Option{
+		cmpopts.IgnoreFields(types.Metadata{}, "ID"),
+		cmpopts.IgnoreFields(types.UserSpecV2{}, "CreatedBy", "Status", "LocalAuth"),
+		cmpopts.EquateEmpty(),
+	}
+}
+
+// resourceDiff renders the changes between the existing Teleport resource and the resource
+// built from the Kubernetes one. The caller is expected to have applied defaults with
+// resourceDrifted first so server-set fields don't show up as changes.
+func resourceDiff(existingResource, desiredResource types.Resource) string {
+	return cmp.Diff(existingResource, desiredResource, compareOptions()...)
+}
+
+// maxDiffMessageLength caps the diff reported in a condition message, the apiserver
+// rejects condition messages over 32768 characters.
+const maxDiffMessageLength = 4096
+
+// getDryRunConditionFromDiff takes the diff computed in dry-run mode and returns a
+// metav1.Condition reporting the changes that would be applied to the Teleport resource.
+func getDryRunConditionFromDiff(diff string) metav1.Condition {
+	if diff == "" {
+		return metav1.Condition{
+			Type:    ConditionTypePendingChanges,
+			Status:  metav1.ConditionFalse,
+			Reason:  ConditionReasonInSync,
+			Message: "Dry-run mode, the Teleport resource is in sync with the Kubernetes resource.",
+		}
+	}
+	if len(diff) > maxDiffMessageLength {
+		diff = diff[:maxDiffMessageLength] + "... (truncated)"
+	}
+	return metav1.Condition{
+		Type:    ConditionTypePendingChanges,
+		Status:  metav1.ConditionTrue,
+		Reason:  ConditionReasonPendingChanges,
+		Message: fmt.Sprintf("Dry-run mode, the following changes were not applied to Teleport: %s", diff),
+	}
+}
+
+// getDriftedCondition takes the diff computed by the drift watcher and returns a
+// metav1.Condition reporting whether the Teleport resource was changed out-of-band.
+func getDriftedCondition(diff string) metav1.Condition {
+	if diff == "" {
+		return metav1.Condition{
+			Type:    ConditionTypeDrifted,
+			Status:  metav1.ConditionFalse,
+			Reason:  ConditionReasonInSync,
+			Message: "The Teleport resource matches the Kubernetes resource.",
+		}
+	}
+	if len(diff) > maxDiffMessageLength {
+		diff = diff[:maxDiffMessageLength] + "... (truncated)"
+	}
+	return metav1.Condition{
+		Type:    ConditionTypeDrifted,
+		Status:  metav1.ConditionTrue,
+		Reason:  ConditionReasonOutOfBandChange,
+		Message: fmt.Sprintf("The Teleport resource was changed out-of-band: %s", diff),
+	}
+}
+
+// getPausedCondition returns a metav1.Condition reporting whether the reconciliation of the
+// resource is suspended by the paused annotation.
+func getPausedCondition(paused bool) metav1.Condition {
+	if paused {
+		return metav1.Condition{
+			Type:    ConditionTypePaused,
+			Status:  metav1.ConditionTrue,
+			Reason:  ConditionReasonPausedAnnotationSet,
+			Message: "Reconciliation is suspended by the paused annotation, no change is propagated to Teleport.",
+		}
+	}
+	return metav1.Condition{
+		Type:    ConditionTypePaused,
+		Status:  metav1.ConditionFalse,
+		Reason:  ConditionReasonReconciliationActive,
+		Message: "Reconciliation is active, the paused annotation is not set.",
+	}
+}
+
 // silentUpdateStatus updates the resource status but swallows the error if the update fails.
 // This should be used when an error already happened, and we're going to re-run the reconciliation loop anyway.
 func silentUpdateStatus(ctx context.Context, client kclient.Client, k8sResource kclient.Object) {
@@ -20,6 +20,7 @@ import (
 	"testing"
 
 	"github.com/gravitational/teleport/api/types"
+	resourcesv2 "github.com/gravitational/teleport/operator/apis/resources/v2"
 	"github.com/gravitational/trace"
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -43,6 +44,7 @@ func TestCheckOwnership(t *testing.T) {
 	tests := []struct {
 		name                    string
 		existingResource        types.Resource
+		adopt                   bool
 		expectedConditionStatus metav1.ConditionStatus
 		expectedConditionReason string
 		check                   check
@@ -101,11 +103,36 @@ func TestCheckOwnership(t *testing.T) {
 			expectedConditionReason: ConditionReasonOriginLabelNotMatching,
 			check:                   hasAlreadyExistsErr(),
 		},
+		{
+			name: "existing unowned resource with adoption",
+			existingResource: &types.UserV2{
+				Metadata: types.Metadata{
+					Name: "existing unowned user being adopted",
+				},
+			},
+			adopt:                   true,
+			expectedConditionStatus: metav1.ConditionTrue,
+			expectedConditionReason: ConditionReasonResourceAdopted,
+			check:                   hasNoErr(),
+		},
+		{
+			name: "existing owned resource with adoption",
+			existingResource: &types.UserV2{
+				Metadata: types.Metadata{
+					Name:   "existing owned user with useless adopt annotation",
+					Labels: map[string]string{types.OriginLabel: types.OriginKubernetes},
+				},
+			},
+			adopt:                   true,
+			expectedConditionStatus: metav1.ConditionTrue,
+			expectedConditionReason: ConditionReasonOriginLabelMatching,
+			check:                   hasNoErr(),
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
 
-			condition, err := checkOwnership(tc.existingResource)
+			condition, err := checkOwnership(tc.existingResource, tc.adopt)
 
 			tc.check(t, err)
 			require.Equal(t, condition.Type, ConditionTypeTeleportResourceOwned)
@@ -114,3 +141,310 @@ func TestCheckOwnership(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckSingletonOwnership(t *testing.T) {
+	tests := []struct {
+		name                    string
+		origin                  string
+		expectedConditionStatus metav1.ConditionStatus
+		expectedConditionReason string
+		shouldFail              bool
+	}{
+		{
+			name:                    "kubernetes origin",
+			origin:                  types.OriginKubernetes,
+			expectedConditionStatus: metav1.ConditionTrue,
+			expectedConditionReason: ConditionReasonOriginLabelMatching,
+			shouldFail:              false,
+		},
+		{
+			name:                    "defaults origin",
+			origin:                  types.OriginDefaults,
+			expectedConditionStatus: metav1.ConditionTrue,
+			expectedConditionReason: ConditionReasonNewResource,
+			shouldFail:              false,
+		},
+		{
+			name:                    "no origin",
+			origin:                  "",
+			expectedConditionStatus: metav1.ConditionTrue,
+			expectedConditionReason: ConditionReasonNewResource,
+			shouldFail:              false,
+		},
+		{
+			name:                    "config-file origin",
+			origin:                  types.OriginConfigFile,
+			expectedConditionStatus: metav1.ConditionFalse,
+			expectedConditionReason: ConditionReasonOriginLabelNotMatching,
+			shouldFail:              true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			existingResource := &types.AuthPreferenceV2{
+				Metadata: types.Metadata{
+					Name: types.MetaNameClusterAuthPreference,
+				},
+			}
+			if tc.origin != "" {
+				existingResource.Metadata.Labels = map[string]string{types.OriginLabel: tc.origin}
+			}
+
+			condition, err := checkSingletonOwnership(existingResource)
+
+			if tc.shouldFail {
+				require.True(t, trace.IsAlreadyExists(err))
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, condition.Type, ConditionTypeTeleportResourceOwned)
+			require.Equal(t, condition.Status, tc.expectedConditionStatus)
+			require.Equal(t, condition.Reason, tc.expectedConditionReason)
+		})
+	}
+}
+
+func TestShouldKeepOnDelete(t *testing.T) {
+	tests := []struct {
+		name         string
+		annotations  map[string]string
+		defaultKeep  bool
+		expectedKeep bool
+	}{
+		{
+			name:         "no annotation, default delete",
+			annotations:  nil,
+			defaultKeep:  false,
+			expectedKeep: false,
+		},
+		{
+			name:         "no annotation, default keep",
+			annotations:  nil,
+			defaultKeep:  true,
+			expectedKeep: true,
+		},
+		{
+			name:         "keep annotation overrides default delete",
+			annotations:  map[string]string{KeepOnDeleteAnnotation: "true"},
+			defaultKeep:  false,
+			expectedKeep: true,
+		},
+		{
+			name:         "delete annotation overrides default keep",
+			annotations:  map[string]string{KeepOnDeleteAnnotation: "false"},
+			defaultKeep:  true,
+			expectedKeep: false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			obj := &resourcesv2.TeleportUser{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "user",
+					Annotations: tc.annotations,
+				},
+			}
+
+			require.Equal(t, tc.expectedKeep, shouldKeepOnDelete(obj, tc.defaultKeep))
+		})
+	}
+}
+
+func TestIsDryRun(t *testing.T) {
+	tests := []struct {
+		name           string
+		annotations    map[string]string
+		defaultDryRun  bool
+		expectedDryRun bool
+	}{
+		{
+			name:           "no annotation, default apply",
+			annotations:    nil,
+			defaultDryRun:  false,
+			expectedDryRun: false,
+		},
+		{
+			name:           "no annotation, default dry-run",
+			annotations:    nil,
+			defaultDryRun:  true,
+			expectedDryRun: true,
+		},
+		{
+			name:           "dry-run annotation overrides default apply",
+			annotations:    map[string]string{DryRunAnnotation: "true"},
+			defaultDryRun:  false,
+			expectedDryRun: true,
+		},
+		{
+			name:           "apply annotation overrides default dry-run",
+			annotations:    map[string]string{DryRunAnnotation: "false"},
+			defaultDryRun:  true,
+			expectedDryRun: false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			obj := &resourcesv2.TeleportUser{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "user",
+					Annotations: tc.annotations,
+				},
+			}
+
+			require.Equal(t, tc.expectedDryRun, isDryRun(obj, tc.defaultDryRun))
+		})
+	}
+}
+
+func TestIsPaused(t *testing.T) {
+	tests := []struct {
+		name           string
+		annotations    map[string]string
+		expectedPaused bool
+	}{
+		{
+			name:           "no annotation",
+			annotations:    nil,
+			expectedPaused: false,
+		},
+		{
+			name:           "paused annotation set",
+			annotations:    map[string]string{PausedAnnotation: "true"},
+			expectedPaused: true,
+		},
+		{
+			name:           "paused annotation set to false",
+			annotations:    map[string]string{PausedAnnotation: "false"},
+			expectedPaused: false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			obj := &resourcesv2.TeleportUser{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "user",
+					Annotations: tc.annotations,
+				},
+			}
+
+			require.Equal(t, tc.expectedPaused, isPaused(obj))
+		})
+	}
+}
+
+func TestResourceDrifted(t *testing.T) {
+	existingRole := func(t *testing.T) types.Role {
+		role, err := types.NewRole("drifted", types.RoleSpecV5{
+			Allow: types.RoleConditions{
+				Logins: []string{"a", "b"},
+			},
+		})
+		require.NoError(t, err)
+		require.NoError(t, role.CheckAndSetDefaults())
+		return role
+	}
+
+	tests := []struct {
+		name             string
+		existingResource func(t *testing.T) types.Role
+		desiredResource  func(t *testing.T) types.Role
+		expectedDrift    bool
+	}{
+		{
+			name:             "no existing resource",
+			existingResource: func(t *testing.T) types.Role { return nil },
+			desiredResource:  existingRole,
+			expectedDrift:    true,
+		},
+		{
+			name:             "identical resource with server-set defaults",
+			existingResource: existingRole,
+			// The desired resource has no defaults applied yet, they must be
+			// ignored by the comparison.
+			desiredResource: func(t *testing.T) types.Role {
+				role, err := types.NewRole("drifted", types.RoleSpecV5{
+					Allow: types.RoleConditions{
+						Logins: []string{"a", "b"},
+					},
+				})
+				require.NoError(t, err)
+				return role
+			},
+			expectedDrift: false,
+		},
+		{
+			name:             "out-of-band spec edit",
+			existingResource: existingRole,
+			desiredResource: func(t *testing.T) types.Role {
+				role, err := types.NewRole("drifted", types.RoleSpecV5{
+					Allow: types.RoleConditions{
+						Logins: []string{"a", "b", "c"},
+					},
+				})
+				require.NoError(t, err)
+				return role
+			},
+			expectedDrift: true,
+		},
+		{
+			name: "backend resource id is not drift",
+			existingResource: func(t *testing.T) types.Role {
+				role := existingRole(t)
+				role.SetResourceID(12)
+				return role
+			},
+			desiredResource: existingRole,
+			expectedDrift:   false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var existing types.Resource
+			if resource := tc.existingResource(t); resource != nil {
+				existing = resource
+			}
+
+			require.Equal(t, tc.expectedDrift, resourceDrifted(existing, tc.desiredResource(t)))
+		})
+	}
+}
+
+func TestCheckSingletonName(t *testing.T) {
+	tests := []struct {
+		name                    string
+		resourceName            string
+		expectedConditionStatus metav1.ConditionStatus
+		expectedConditionReason string
+		shouldFail              bool
+	}{
+		{
+			name:                    "name matching the singleton",
+			resourceName:            "cluster-auth-preference",
+			expectedConditionStatus: metav1.ConditionTrue,
+			expectedConditionReason: ConditionReasonNameMatching,
+			shouldFail:              false,
+		},
+		{
+			name:                    "conflicting instance",
+			resourceName:            "my-auth-preference",
+			expectedConditionStatus: metav1.ConditionFalse,
+			expectedConditionReason: ConditionReasonConflictingInstance,
+			shouldFail:              true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+
+			condition, err := checkSingletonName(tc.resourceName, "cluster-auth-preference")
+
+			if tc.shouldFail {
+				require.True(t, trace.IsBadParameter(err))
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, condition.Type, ConditionTypeValidSingletonName)
+			require.Equal(t, condition.Status, tc.expectedConditionStatus)
+			require.Equal(t, condition.Reason, tc.expectedConditionReason)
+		})
+	}
+}
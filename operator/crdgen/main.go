@@ -95,6 +95,22 @@ func generateSchema(file *File, groupName string, resp *gogoplugin.CodeGenerator
 		return trace.Wrap(err)
 	}
 
+	if err := generator.addResource(file, "ProvisionTokenV2"); err != nil {
+		return trace.Wrap(err)
+	}
+
+	if err := generator.addResource(file, "AuthPreferenceV2"); err != nil {
+		return trace.Wrap(err)
+	}
+
+	if err := generator.addResource(file, "ClusterNetworkingConfigV2"); err != nil {
+		return trace.Wrap(err)
+	}
+
+	if err := generator.addResource(file, "SessionRecordingConfigV2"); err != nil {
+		return trace.Wrap(err)
+	}
+
 	for _, root := range generator.roots {
 		crd := root.CustomResourceDefinition()
 		data, err := yaml.Marshal(crd)
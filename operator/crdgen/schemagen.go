@@ -205,6 +205,10 @@ func (generator *SchemaGenerator) singularProp(field *Field, prop *apiextv1.JSON
 	case field.IsInt64() || field.IsUint64():
 		prop.Type = "integer"
 		prop.Format = "int64"
+	case field.IsEnum():
+		// Enums are serialized as their integer value.
+		prop.Type = "integer"
+		prop.Format = "int32"
 	case field.TypeName() == ".wrappers.LabelValues":
 		prop.Type = "object"
 		prop.AdditionalProperties = &apiextv1.JSONSchemaPropsOrBool{
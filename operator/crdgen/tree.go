@@ -253,6 +253,10 @@ func (field Field) IsUint64() bool {
 	return field.desc.GetType() == gogodesc.FieldDescriptorProto_TYPE_UINT64
 }
 
+func (field Field) IsEnum() bool {
+	return field.desc.GetType() == gogodesc.FieldDescriptorProto_TYPE_ENUM
+}
+
 func (field Field) IsInt32() bool {
 	return field.desc.GetType() == gogodesc.FieldDescriptorProto_TYPE_INT32
 }
@@ -0,0 +1,201 @@
+/*
+Copyright 2022 Gravitational, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/gravitational/teleport/api/types"
+	"github.com/gravitational/teleport/operator/apis/resources"
+	resourcesv2 "github.com/gravitational/teleport/operator/apis/resources/v2"
+	resourcesv5 "github.com/gravitational/teleport/operator/apis/resources/v5"
+	resourcescontrollers "github.com/gravitational/teleport/operator/controllers/resources"
+	"github.com/gravitational/teleport/operator/sidecar"
+	"github.com/gravitational/trace"
+)
+
+// runExport connects to Teleport and emits Kubernetes manifests for the existing
+// resources of the requested kinds, to bootstrap GitOps adoption of a cluster that
+// was managed by hand. The manifests carry the adopt annotation so the operator
+// takes ownership of the pre-existing Teleport resources when they are applied.
+//
+// Teleport resource names are used as manifest names as-is, names that are not
+// valid Kubernetes object names must be fixed by hand before applying.
+func runExport(ctx context.Context, accessor sidecar.ClientAccessor, kinds string, out io.Writer) error {
+	teleportClient, err := accessor(ctx)
+	if err != nil {
+		return trace.Wrap(err)
+	}
+
+	for _, kind := range strings.Split(kinds, ",") {
+		switch strings.TrimSpace(kind) {
+		case "roles":
+			err = exportRoles(ctx, teleportClient, out)
+		case "users":
+			err = exportUsers(ctx, teleportClient, out)
+		case "tokens":
+			err = exportTokens(ctx, teleportClient, out)
+		default:
+			return trace.BadParameter("unsupported kind %q, supported kinds are: roles, users, tokens", kind)
+		}
+		if err != nil {
+			return trace.Wrap(err)
+		}
+	}
+	return nil
+}
+
+func exportRoles(ctx context.Context, teleportClient roleGetter, out io.Writer) error {
+	roles, err := teleportClient.GetRoles(ctx)
+	if err != nil {
+		return trace.Wrap(err)
+	}
+	for _, role := range roles {
+		roleV5, ok := role.(*types.RoleV5)
+		if !ok {
+			return trace.BadParameter("unsupported role type %T for role %q", role, role.GetName())
+		}
+		manifest := &resourcesv5.TeleportRole{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: resourcesv5.GroupVersion.String(),
+				Kind:       "TeleportRole",
+			},
+			ObjectMeta: manifestObjectMeta(role),
+			Spec:       resourcesv5.TeleportRoleSpec(roleV5.Spec),
+		}
+		if err := writeManifest(out, manifest); err != nil {
+			return trace.Wrap(err)
+		}
+	}
+	return nil
+}
+
+func exportUsers(ctx context.Context, teleportClient userGetter, out io.Writer) error {
+	users, err := teleportClient.GetUsers(false)
+	if err != nil {
+		return trace.Wrap(err)
+	}
+	for _, user := range users {
+		// Bot users are managed by Machine ID, they must not become CRs.
+		if _, ok := user.GetMetadata().Labels[types.BotLabel]; ok {
+			continue
+		}
+		userV2, ok := user.(*types.UserV2)
+		if !ok {
+			return trace.BadParameter("unsupported user type %T for user %q", user, user.GetName())
+		}
+		manifest := &resourcesv2.TeleportUser{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: resourcesv2.GroupVersion.String(),
+				Kind:       "TeleportUser",
+			},
+			ObjectMeta: manifestObjectMeta(user),
+			Spec:       resourcesv2.TeleportUserSpec(userV2.Spec),
+		}
+		if err := writeManifest(out, manifest); err != nil {
+			return trace.Wrap(err)
+		}
+	}
+	return nil
+}
+
+func exportTokens(ctx context.Context, teleportClient tokenGetter, out io.Writer) error {
+	tokens, err := teleportClient.GetTokens(ctx)
+	if err != nil {
+		return trace.Wrap(err)
+	}
+	for _, token := range tokens {
+		tokenV2, ok := token.(*types.ProvisionTokenV2)
+		if !ok {
+			return trace.BadParameter("unsupported token type %T for token %q", token, token.GetName())
+		}
+		manifest := &resourcesv2.TeleportProvisionToken{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: resourcesv2.GroupVersion.String(),
+				Kind:       "TeleportProvisionToken",
+			},
+			ObjectMeta: manifestObjectMeta(token),
+			Spec:       resourcesv2.TeleportProvisionTokenSpec(tokenV2.Spec),
+		}
+		if err := writeManifest(out, manifest); err != nil {
+			return trace.Wrap(err)
+		}
+	}
+	return nil
+}
+
+// manifestObjectMeta maps the Teleport resource metadata back to the Kubernetes
+// metadata the operator reads in ToTeleport: the origin label is owned by the
+// operator and dropped, the description becomes an annotation, and the adopt
+// annotation lets the operator take ownership of the pre-existing resource.
+func manifestObjectMeta(resource types.Resource) metav1.ObjectMeta {
+	metadata := resource.GetMetadata()
+
+	var labels map[string]string
+	for key, value := range metadata.Labels {
+		if key == types.OriginLabel {
+			continue
+		}
+		if labels == nil {
+			labels = make(map[string]string)
+		}
+		labels[key] = value
+	}
+
+	annotations := map[string]string{
+		resourcescontrollers.AdoptAnnotation: "true",
+	}
+	if metadata.Description != "" {
+		annotations[resources.DescriptionKey] = metadata.Description
+	}
+
+	return metav1.ObjectMeta{
+		Name:        resource.GetName(),
+		Labels:      labels,
+		Annotations: annotations,
+	}
+}
+
+func writeManifest(out io.Writer, manifest interface{}) error {
+	data, err := yaml.Marshal(manifest)
+	if err != nil {
+		return trace.Wrap(err)
+	}
+	if _, err := fmt.Fprintf(out, "---\n%s", data); err != nil {
+		return trace.Wrap(err)
+	}
+	return nil
+}
+
+// Narrow interfaces keep the export functions testable without a full auth client.
+type roleGetter interface {
+	GetRoles(ctx context.Context) ([]types.Role, error)
+}
+
+type userGetter interface {
+	GetUsers(withSecrets bool) ([]types.User, error)
+}
+
+type tokenGetter interface {
+	GetTokens(ctx context.Context) ([]types.ProvisionToken, error)
+}
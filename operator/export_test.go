@@ -0,0 +1,70 @@
+/*
+Copyright 2022 Gravitational, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/yaml"
+
+	"github.com/gravitational/teleport/api/types"
+	resourcesv5 "github.com/gravitational/teleport/operator/apis/resources/v5"
+)
+
+type fakeRoleGetter struct {
+	roles []types.Role
+}
+
+func (f *fakeRoleGetter) GetRoles(ctx context.Context) ([]types.Role, error) {
+	return f.roles, nil
+}
+
+func TestExportRoles(t *testing.T) {
+	ctx := context.Background()
+
+	role, err := types.NewRole("editors", types.RoleSpecV5{
+		Allow: types.RoleConditions{
+			Logins: []string{"root"},
+		},
+	})
+	require.NoError(t, err)
+	meta := role.GetMetadata()
+	meta.Description = "An editor role"
+	meta.Labels = map[string]string{
+		types.OriginLabel: types.OriginKubernetes,
+		"team":            "platform",
+	}
+	role.SetMetadata(meta)
+
+	var out bytes.Buffer
+	require.NoError(t, exportRoles(ctx, &fakeRoleGetter{roles: []types.Role{role}}, &out))
+
+	var manifest resourcesv5.TeleportRole
+	require.NoError(t, yaml.Unmarshal(out.Bytes(), &manifest))
+	require.Equal(t, resourcesv5.GroupVersion.String(), manifest.APIVersion)
+	require.Equal(t, "TeleportRole", manifest.Kind)
+	require.Equal(t, "editors", manifest.Name)
+	// The origin label belongs to the operator and must not survive the export,
+	// the other labels must.
+	require.Equal(t, map[string]string{"team": "platform"}, manifest.Labels)
+	require.Equal(t, "true", manifest.Annotations["teleport.dev/adopt"])
+	require.Equal(t, "An editor role", manifest.Annotations["description"])
+	require.Equal(t, []string{"root"}, manifest.Spec.Allow.Logins)
+}
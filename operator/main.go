@@ -22,22 +22,31 @@ import (
 	"time"
 
 	"github.com/gravitational/trace"
+	uberzap "go.uber.org/zap"
 
 	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
 	// to ensure that exec-entrypoint and run can make use of them.
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
 
 	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
 	ctrl "sigs.k8s.io/controller-runtime"
-	"sigs.k8s.io/controller-runtime/pkg/healthz"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 
 	"github.com/gravitational/teleport/lib/utils"
+	resourcesv1 "github.com/gravitational/teleport/operator/apis/resources/v1"
 	resourcesv2 "github.com/gravitational/teleport/operator/apis/resources/v2"
 	resourcesv5 "github.com/gravitational/teleport/operator/apis/resources/v5"
+	resourcesv6 "github.com/gravitational/teleport/operator/apis/resources/v6"
+	resourcesv7 "github.com/gravitational/teleport/operator/apis/resources/v7"
 	resourcescontrollers "github.com/gravitational/teleport/operator/controllers/resources"
 	"github.com/gravitational/teleport/operator/sidecar"
 	//+kubebuilder:scaffold:imports
@@ -52,7 +61,10 @@ func init() {
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
 
 	utilruntime.Must(resourcesv5.AddToScheme(scheme))
+	utilruntime.Must(resourcesv6.AddToScheme(scheme))
+	utilruntime.Must(resourcesv7.AddToScheme(scheme))
 	utilruntime.Must(resourcesv2.AddToScheme(scheme))
+	utilruntime.Must(resourcesv1.AddToScheme(scheme))
 	//+kubebuilder:scaffold:scheme
 
 	utilruntime.Must(apiextv1.AddToScheme(scheme))
@@ -64,13 +76,43 @@ func main() {
 	var err error
 	var metricsAddr string
 	var probeAddr string
+	var leaderElection bool
 	var leaderElectionID string
+	var leaderElectionNamespace string
 	var syncPeriodString string
+	var keepResourcesOnDelete bool
+	var dryRun bool
+	var teleportAddr string
+	var identityFilePath string
+	var credentialsSecret string
+	var watchNamespace string
+	var labelSelector string
+	var gcPeriodString string
+	var connectivityGracePeriodString string
+	var exportKinds string
+	var driftWatch bool
+	var driftAutoRevert bool
+	var logLevelString string
 
 	flag.StringVar(&metricsAddr, "metrics-bind-address", ":8080", "The address the metric endpoint binds to.")
 	flag.StringVar(&probeAddr, "health-probe-bind-address", ":8081", "The address the probe endpoint binds to.")
+	flag.BoolVar(&leaderElection, "leader-elect", true, "Enable leader election. Enabling this ensures there is only one active reconciler when running multiple operator replicas.")
 	flag.StringVar(&leaderElectionID, "leader-election-id", "431e83f4.teleport.dev", "Leader Election Id to use")
+	flag.StringVar(&leaderElectionNamespace, "leader-election-namespace", "", "Namespace of the leader election lease, defaults to the operator namespace")
 	flag.StringVar(&syncPeriodString, "sync-period", "10h", "Operator sync period (format: https://pkg.go.dev/time#ParseDuration)")
+	flag.BoolVar(&keepResourcesOnDelete, "keep-resources-on-delete", false, "Keep Teleport resources when their Kubernetes resource is deleted, can be overridden per-resource with the teleport.dev/keep-on-delete annotation")
+	flag.BoolVar(&dryRun, "dry-run", false, "Compute and report the changes in the CR status conditions without applying them to Teleport, can be overridden per-resource with the teleport.dev/dry-run annotation")
+	flag.StringVar(&teleportAddr, "teleport-addr", "", "Comma-separated addresses of the Teleport auth or proxy servers, tried in order, used with -identity-file")
+	flag.StringVar(&identityFilePath, "identity-file", "", "Path to an identity file renewed by tbot (e.g. a mounted Secret), used instead of the auth-local bot bootstrap")
+	flag.StringVar(&credentialsSecret, "credentials-secret", "", "Name of a Secret in the operator namespace holding the Teleport address and a tbot-renewed identity, used to manage a remote Teleport cluster")
+	flag.StringVar(&watchNamespace, "watch-namespace", "", "Namespace to watch for Kubernetes resources, defaults to the operator namespace")
+	flag.StringVar(&labelSelector, "label-selector", "", "Only reconcile Kubernetes resources matching this label selector (format: https://pkg.go.dev/k8s.io/apimachinery/pkg/labels#Parse)")
+	flag.StringVar(&gcPeriodString, "gc-period", "0", "Period between two garbage collections of operator-owned Teleport resources whose Kubernetes resource is gone, 0 disables the garbage collection (format: https://pkg.go.dev/time#ParseDuration)")
+	flag.StringVar(&connectivityGracePeriodString, "connectivity-grace-period", sidecar.DefaultConnectivityGracePeriod.String(), "Duration the Teleport connectivity pings may fail before the health probes report a failure (format: https://pkg.go.dev/time#ParseDuration)")
+	flag.StringVar(&exportKinds, "export", "", "Print Kubernetes manifests for the existing Teleport resources of the given comma-separated kinds (roles, users, tokens) on stdout and exit, instead of running the controllers")
+	flag.BoolVar(&driftWatch, "drift-watch", false, "Watch the Teleport events and report out-of-band changes to operator-owned resources in the Drifted status condition, without waiting for the next sync period")
+	flag.BoolVar(&driftAutoRevert, "drift-auto-revert", false, "Re-apply the Kubernetes resource as soon as an out-of-band change is detected by -drift-watch")
+	flag.StringVar(&logLevelString, "log-level", "info", "Log verbosity (debug, info, warn, error). The level is adjustable at runtime with a GET/PUT on the /log-level path of the metrics endpoint")
 
 	opts := zap.Options{
 		Development: true,
@@ -78,6 +120,16 @@ func main() {
 	opts.BindFlags(flag.CommandLine)
 	flag.Parse()
 
+	// The level lives in an atomic so it can be changed at runtime, e.g. raising
+	// the verbosity on a misbehaving operator without restarting it and losing
+	// its state. At the debug level the per-step reconciliation logs show up.
+	logLevel := uberzap.NewAtomicLevel()
+	if err := logLevel.UnmarshalText([]byte(logLevelString)); err != nil {
+		setupLog.Error(err, "invalid log-level, supported values are: debug, info, warn, error")
+		os.Exit(1)
+	}
+	opts.Level = logLevel
+
 	ctrl.SetLogger(zap.New(zap.UseFlagOptions(&opts)))
 
 	namespace, err := GetKubernetesNamespace()
@@ -92,22 +144,81 @@ func main() {
 		os.Exit(1)
 	}
 
-	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
+	gcPeriod, err := time.ParseDuration(gcPeriodString)
+	if err != nil {
+		setupLog.Error(err, "invalid gc-period, please ensure the value is currectly parsed with https://pkg.go.dev/time#ParseDuration")
+		os.Exit(1)
+	}
+
+	connectivityGracePeriod, err := time.ParseDuration(connectivityGracePeriodString)
+	if err != nil {
+		setupLog.Error(err, "invalid connectivity-grace-period, please ensure the value is currectly parsed with https://pkg.go.dev/time#ParseDuration")
+		os.Exit(1)
+	}
+
+	if leaderElectionNamespace == "" {
+		leaderElectionNamespace = namespace
+	}
+	if watchNamespace == "" {
+		watchNamespace = namespace
+	}
+
+	mgrOptions := ctrl.Options{
 		Scheme:                 scheme,
 		MetricsBindAddress:     metricsAddr,
 		Port:                   9443,
 		HealthProbeBindAddress: probeAddr,
-		LeaderElection:         true,
+		LeaderElection:         leaderElection,
 		LeaderElectionID:       leaderElectionID,
-		Namespace:              namespace,
-		SyncPeriod:             &syncPeriod,
-	})
+		// Leases are held by a single replica at a time and released on shutdown,
+		// so a replaced replica hands over to the new one without waiting for the
+		// lease to expire.
+		LeaderElectionNamespace:       leaderElectionNamespace,
+		LeaderElectionResourceLock:    resourcelock.LeasesResourceLock,
+		LeaderElectionReleaseOnCancel: true,
+		Namespace:                     watchNamespace,
+		SyncPeriod:                    &syncPeriod,
+	}
+
+	if labelSelector != "" {
+		selector, err := labels.Parse(labelSelector)
+		if err != nil {
+			setupLog.Error(err, "invalid label-selector, please ensure the value is correctly parsed with https://pkg.go.dev/k8s.io/apimachinery/pkg/labels#Parse")
+			os.Exit(1)
+		}
+		// Only the reconciled CR kinds are filtered. Support objects like the
+		// connection Secrets or the namespaces stay visible regardless of their
+		// labels. Several operators with disjoint selectors can then share a
+		// cluster without fighting over the same resources.
+		selectorsByObject := cache.SelectorsByObject{}
+		for _, obj := range []kclient.Object{
+			&resourcesv5.TeleportRole{},
+			&resourcesv2.TeleportUser{},
+			&resourcesv2.TeleportProvisionToken{},
+			&resourcesv2.TeleportAuthPreference{},
+			&resourcesv2.TeleportClusterNetworkingConfig{},
+			&resourcesv2.TeleportSessionRecordingConfig{},
+		} {
+			selectorsByObject[obj] = cache.ObjectSelector{Label: selector}
+		}
+		mgrOptions.NewCache = cache.BuilderWithOptions(cache.Options{SelectorsByObject: selectorsByObject})
+	}
+
+	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), mgrOptions)
 	if err != nil {
 		setupLog.Error(err, "unable to start manager")
 		os.Exit(1)
 	}
 
+	// zap's AtomicLevel serves its own level over HTTP:
+	// `curl -XPUT -d '{"level":"debug"}' $METRICS_ADDR/log-level`
+	if err := mgr.AddMetricsExtraHandler("/log-level", logLevel); err != nil {
+		setupLog.Error(err, "unable to serve the log level endpoint")
+		os.Exit(1)
+	}
+
 	var bot *sidecar.Bot
+	var teleportClientAccessor sidecar.ClientAccessor
 
 	retry, err := utils.NewLinear(utils.LinearConfig{
 		Step: 100 * time.Millisecond,
@@ -117,22 +228,90 @@ func main() {
 		setupLog.Error(err, "failed to setup retry")
 		os.Exit(1)
 	}
-	if err := retry.For(ctx, func() error {
-		bot, err = sidecar.CreateAndBootstrapBot(ctx, sidecar.Options{})
+	if credentialsSecret != "" {
+		// The credentials live in a Secret kept up-to-date by an external tbot,
+		// the operator can manage a Teleport cluster running anywhere it can reach.
+		teleportClientAccessor = sidecar.NewSecretClientAccessor(mgr.GetAPIReader(), namespace, credentialsSecret)
+		if err := retry.For(ctx, func() error {
+			_, err := teleportClientAccessor(ctx)
+			if err != nil {
+				setupLog.Error(err, "failed to connect to teleport cluster, backing off")
+			}
+			return trace.Wrap(err)
+		}); err != nil {
+			setupLog.Error(err, "failed to setup teleport client")
+			os.Exit(1)
+		}
+
+		// Maintain a TeleportConnection resource describing the connection the operator
+		// uses, its status conditions report the connectivity with the remote cluster.
+		connection := &resourcesv1.TeleportConnection{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "teleport-operator",
+				Namespace: namespace,
+			},
+			Spec: resourcesv1.TeleportConnectionSpec{
+				SecretName: credentialsSecret,
+			},
+		}
+		if err := mgr.GetClient().Create(ctx, connection); err != nil && !apierrors.IsAlreadyExists(err) {
+			setupLog.Error(err, "failed to create the TeleportConnection reporting the operator connectivity")
+			os.Exit(1)
+		}
+	} else if identityFilePath != "" {
+		// The identity is renewed by an external tbot, no need to bootstrap
+		// a bot of our own. This is the only supported mode when the operator
+		// does not run next to the auth server.
+		teleportClientAccessor, err = sidecar.NewIdentityFileClientAccessor(sidecar.Options{
+			Addr:             teleportAddr,
+			IdentityFilePath: identityFilePath,
+		})
 		if err != nil {
-			setupLog.Error(err, "failed to connect to teleport cluster, backing off")
+			setupLog.Error(err, "failed to setup teleport client from the identity file")
+			os.Exit(1)
 		}
-		return trace.Wrap(err)
-	}); err != nil {
-		setupLog.Error(err, "failed to setup teleport client")
-		os.Exit(1)
+		if err := retry.For(ctx, func() error {
+			_, err := teleportClientAccessor(ctx)
+			if err != nil {
+				setupLog.Error(err, "failed to connect to teleport cluster, backing off")
+			}
+			return trace.Wrap(err)
+		}); err != nil {
+			setupLog.Error(err, "failed to setup teleport client")
+			os.Exit(1)
+		}
+	} else {
+		if err := retry.For(ctx, func() error {
+			bot, err = sidecar.CreateAndBootstrapBot(ctx, sidecar.Options{})
+			if err != nil {
+				setupLog.Error(err, "failed to connect to teleport cluster, backing off")
+			}
+			return trace.Wrap(err)
+		}); err != nil {
+			setupLog.Error(err, "failed to setup teleport client")
+			os.Exit(1)
+		}
+		teleportClientAccessor = bot.GetClient
 	}
 	setupLog.Info("connected to Teleport")
 
+	// In export mode the operator is a one-shot command: it dumps manifests for
+	// the existing Teleport resources and exits without starting the controllers.
+	if exportKinds != "" {
+		if err := runExport(ctx, teleportClientAccessor, exportKinds, os.Stdout); err != nil {
+			setupLog.Error(err, "failed to export the Teleport resources")
+			os.Exit(1)
+		}
+		return
+	}
+
 	if err = (&resourcescontrollers.RoleReconciler{
 		Client:                 mgr.GetClient(),
 		Scheme:                 mgr.GetScheme(),
-		TeleportClientAccessor: bot.GetClient,
+		TeleportClientAccessor: teleportClientAccessor,
+		KeepResourcesOnDelete:  keepResourcesOnDelete,
+		DryRun:                 dryRun,
+		Recorder:               mgr.GetEventRecorderFor("teleport-operator"),
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "TeleportRole")
 		os.Exit(1)
@@ -141,24 +320,137 @@ func main() {
 	if err = (&resourcescontrollers.UserReconciler{
 		Client:                 mgr.GetClient(),
 		Scheme:                 mgr.GetScheme(),
-		TeleportClientAccessor: bot.GetClient,
+		TeleportClientAccessor: teleportClientAccessor,
+		KeepResourcesOnDelete:  keepResourcesOnDelete,
+		DryRun:                 dryRun,
+		Recorder:               mgr.GetEventRecorderFor("teleport-operator"),
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "TeleportUser")
 		os.Exit(1)
 	}
+	if err = (&resourcescontrollers.ProvisionTokenReconciler{
+		Client:                 mgr.GetClient(),
+		Scheme:                 mgr.GetScheme(),
+		TeleportClientAccessor: teleportClientAccessor,
+		KeepResourcesOnDelete:  keepResourcesOnDelete,
+		DryRun:                 dryRun,
+		Recorder:               mgr.GetEventRecorderFor("teleport-operator"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "TeleportProvisionToken")
+		os.Exit(1)
+	}
+	if err = (&resourcescontrollers.AuthPreferenceReconciler{
+		Client:                 mgr.GetClient(),
+		Scheme:                 mgr.GetScheme(),
+		TeleportClientAccessor: teleportClientAccessor,
+		KeepResourcesOnDelete:  keepResourcesOnDelete,
+		DryRun:                 dryRun,
+		Recorder:               mgr.GetEventRecorderFor("teleport-operator"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "TeleportAuthPreference")
+		os.Exit(1)
+	}
+	if err = (&resourcescontrollers.ClusterNetworkingConfigReconciler{
+		Client:                 mgr.GetClient(),
+		Scheme:                 mgr.GetScheme(),
+		TeleportClientAccessor: teleportClientAccessor,
+		KeepResourcesOnDelete:  keepResourcesOnDelete,
+		DryRun:                 dryRun,
+		Recorder:               mgr.GetEventRecorderFor("teleport-operator"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "TeleportClusterNetworkingConfig")
+		os.Exit(1)
+	}
+	if err = (&resourcescontrollers.SessionRecordingConfigReconciler{
+		Client:                 mgr.GetClient(),
+		Scheme:                 mgr.GetScheme(),
+		TeleportClientAccessor: teleportClientAccessor,
+		KeepResourcesOnDelete:  keepResourcesOnDelete,
+		DryRun:                 dryRun,
+		Recorder:               mgr.GetEventRecorderFor("teleport-operator"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "TeleportSessionRecordingConfig")
+		os.Exit(1)
+	}
+	if err = (&resourcescontrollers.ConnectionReconciler{
+		Client: mgr.GetClient(),
+		Scheme: mgr.GetScheme(),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "TeleportConnection")
+		os.Exit(1)
+	}
+	// The conversion webhook translates between the TeleportRole CRD versions,
+	// v5 is the hub version all the others convert through.
+	if os.Getenv("ENABLE_WEBHOOKS") != "false" {
+		if err = ctrl.NewWebhookManagedBy(mgr).For(&resourcesv5.TeleportRole{}).Complete(); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "TeleportRole")
+			os.Exit(1)
+		}
+	}
 	//+kubebuilder:scaffold:builder
 
-	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
+	// The probes report a failure when the operator has not been able to reach
+	// Teleport for the whole grace period, Kubernetes then restarts or reschedules
+	// the operator instead of leaving it running without reconciling.
+	connectivityChecker := &sidecar.ConnectivityChecker{
+		ClientAccessor: teleportClientAccessor,
+		GracePeriod:    connectivityGracePeriod,
+	}
+	if err := mgr.Add(connectivityChecker); err != nil {
+		setupLog.Error(err, "unable to setup the connectivity checker")
+		os.Exit(1)
+	}
+
+	if err := mgr.AddHealthzCheck("healthz", connectivityChecker.Check); err != nil {
 		setupLog.Error(err, "unable to set up health check")
 		os.Exit(1)
 	}
-	if err := mgr.AddReadyzCheck("readyz", healthz.Ping); err != nil {
+	if err := mgr.AddReadyzCheck("readyz", connectivityChecker.Check); err != nil {
 		setupLog.Error(err, "unable to set up ready check")
 		os.Exit(1)
 	}
 
-	if err := mgr.Add(bot); err != nil {
-		setupLog.Error(err, "unable to setup bot ")
+	if bot != nil {
+		if err := mgr.Add(bot); err != nil {
+			setupLog.Error(err, "unable to setup bot ")
+		}
+	}
+
+	// The garbage collector catches up on the CRs deleted while the operator was
+	// down, whose finalizers never ran. It never deletes in dry-run mode.
+	if gcPeriod > 0 && !dryRun {
+		if err := mgr.Add(&resourcescontrollers.GarbageCollector{
+			Reader:                 mgr.GetAPIReader(),
+			TeleportClientAccessor: teleportClientAccessor,
+			Interval:               gcPeriod,
+		}); err != nil {
+			setupLog.Error(err, "unable to setup the garbage collector")
+			os.Exit(1)
+		}
+	}
+
+	// The drift watcher surfaces tctl edits to operator-owned resources in near
+	// real time. It never reverts in dry-run mode.
+	if driftWatch {
+		if err := mgr.Add(&resourcescontrollers.DriftWatcher{
+			Client:                 mgr.GetClient(),
+			TeleportClientAccessor: teleportClientAccessor,
+			Namespace:              watchNamespace,
+			AutoRevert:             driftAutoRevert && !dryRun,
+		}); err != nil {
+			setupLog.Error(err, "unable to setup the drift watcher")
+			os.Exit(1)
+		}
+	}
+
+	// After an operator upgrade the role CRs can still be stored at an older API
+	// version, the migrator rewrites them once to the storage version.
+	if err := mgr.Add(&resourcescontrollers.StorageVersionMigrator{
+		Client: mgr.GetClient(),
+		Reader: mgr.GetAPIReader(),
+	}); err != nil {
+		setupLog.Error(err, "unable to setup the storage version migrator")
+		os.Exit(1)
 	}
 
 	setupLog.Info("starting manager")
@@ -0,0 +1,117 @@
+/*
+Copyright 2022 Gravitational, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sidecar
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gravitational/trace"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// connectivityPingInterval is the time between two Teleport connectivity pings.
+	connectivityPingInterval = 30 * time.Second
+	// DefaultConnectivityGracePeriod is how long the pings may fail before the
+	// probes report a failure.
+	DefaultConnectivityGracePeriod = 5 * time.Minute
+)
+
+// ConnectivityChecker periodically pings the Teleport cluster and remembers when the
+// last ping succeeded. Health probes wired to Check report a failure once the pings
+// have been failing for longer than the grace period, so Kubernetes restarts or
+// reschedules the operator instead of leaving it running without reconciling.
+// It implements manager.Runnable and runs on every replica, each one must watch its
+// own connectivity regardless of leader election.
+type ConnectivityChecker struct {
+	ClientAccessor ClientAccessor
+	// GracePeriod is how long the pings may fail before Check reports an error.
+	GracePeriod time.Duration
+
+	mu          sync.Mutex
+	lastSuccess time.Time
+	lastError   error
+}
+
+func (c *ConnectivityChecker) NeedLeaderElection() bool {
+	return false
+}
+
+func (c *ConnectivityChecker) Start(ctx context.Context) error {
+	// The operator connected during startup, the grace period starts now.
+	c.setSuccess()
+
+	ticker := time.NewTicker(connectivityPingInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := c.ping(ctx); err != nil {
+				log.WithError(err).Warn("Failed to ping the Teleport cluster")
+				c.setError(err)
+			} else {
+				c.setSuccess()
+			}
+		}
+	}
+}
+
+func (c *ConnectivityChecker) ping(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, connectivityPingInterval)
+	defer cancel()
+
+	client, err := c.ClientAccessor(ctx)
+	if err != nil {
+		return trace.Wrap(err)
+	}
+	_, err = client.Ping(ctx)
+	return trace.Wrap(err)
+}
+
+// Check implements healthz.Checker, it can back both the readiness and the
+// liveness probe.
+func (c *ConnectivityChecker) Check(_ *http.Request) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// The manager has not started the checker yet.
+	if c.lastSuccess.IsZero() {
+		return nil
+	}
+	if since := time.Since(c.lastSuccess); since > c.GracePeriod {
+		return trace.Errorf("cannot reach the Teleport cluster since %s: %v", since.Round(time.Second), c.lastError)
+	}
+	return nil
+}
+
+func (c *ConnectivityChecker) setSuccess() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.lastSuccess = time.Now()
+	c.lastError = nil
+}
+
+func (c *ConnectivityChecker) setError(err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.lastError = err
+}
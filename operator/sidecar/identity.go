@@ -0,0 +1,147 @@
+/*
+Copyright 2022 Gravitational, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sidecar
+
+import (
+	"context"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gravitational/teleport/api/identityfile"
+	"github.com/gravitational/teleport/lib/auth"
+	"github.com/gravitational/teleport/lib/auth/authclient"
+	"github.com/gravitational/teleport/lib/utils"
+	"github.com/gravitational/trace"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// SecretAddrKey is the Secret data key holding the address of the Teleport auth or proxy server.
+	SecretAddrKey = "addr"
+	// SecretIdentityKey is the Secret data key holding the identity file renewed by tbot.
+	SecretIdentityKey = "identity"
+)
+
+// NewIdentityFileClientAccessor returns a ClientAccessor building clients from an
+// identity file renewed out-of-band by a tbot instance, typically mounted from a
+// Kubernetes Secret or a shared directory. This allows the operator to run in
+// clusters that don't host the auth server, where the embedded bot cannot
+// bootstrap itself through a local admin socket.
+//
+// The identity file is re-read on every call so certificate renewals performed
+// by tbot are picked up without restarting the operator.
+//
+// Several comma-separated addresses can be given, they are tried in order so an
+// auth rolling restart fails over to the remaining endpoints.
+func NewIdentityFileClientAccessor(opts Options) (ClientAccessor, error) {
+	if opts.IdentityFilePath == "" {
+		return nil, trace.BadParameter("identity file path is empty")
+	}
+
+	addrs, err := parseAddrList(opts.Addr)
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	return func(ctx context.Context) (auth.ClientI, error) {
+		id, err := identityfile.ReadFile(opts.IdentityFilePath)
+		if err != nil {
+			return nil, trace.Wrap(err, "failed to read identity file, ensure tbot keeps it up-to-date")
+		}
+
+		return connectWithIdentityFile(ctx, id, addrs)
+	}, nil
+}
+
+// NewSecretClientAccessor returns a ClientAccessor reading the Teleport address and a
+// tbot-renewed identity file from a Kubernetes Secret. Unlike NewIdentityFileClientAccessor
+// nothing has to be mounted in the operator pod, so the credentials can target a Teleport
+// cluster running elsewhere, e.g. Teleport Cloud or another Kubernetes cluster.
+//
+// The Secret is re-read on every call so credential renewals are picked up without
+// restarting the operator. The addr key accepts a comma-separated list of
+// addresses, tried in order when an endpoint stops answering.
+func NewSecretClientAccessor(reader kclient.Reader, namespace, secretName string) ClientAccessor {
+	return func(ctx context.Context) (auth.ClientI, error) {
+		var secret corev1.Secret
+		if err := reader.Get(ctx, kclient.ObjectKey{Namespace: namespace, Name: secretName}, &secret); err != nil {
+			return nil, trace.Wrap(err, "failed to read the credentials secret")
+		}
+
+		addr := string(secret.Data[SecretAddrKey])
+		if addr == "" {
+			return nil, trace.BadParameter("secret %s/%s is missing the %q key", namespace, secretName, SecretAddrKey)
+		}
+		addrs, err := parseAddrList(addr)
+		if err != nil {
+			return nil, trace.Wrap(err)
+		}
+
+		identityContent := secret.Data[SecretIdentityKey]
+		if len(identityContent) == 0 {
+			return nil, trace.BadParameter("secret %s/%s is missing the %q key", namespace, secretName, SecretIdentityKey)
+		}
+		id, err := identityfile.FromString(string(identityContent))
+		if err != nil {
+			return nil, trace.Wrap(err, "failed to parse the identity file, ensure tbot keeps it up-to-date")
+		}
+
+		return connectWithIdentityFile(ctx, id, addrs)
+	}
+}
+
+// parseAddrList parses a comma-separated list of Teleport auth or proxy addresses.
+func parseAddrList(addrList string) ([]utils.NetAddr, error) {
+	var addrs []string
+	for _, addr := range strings.Split(addrList, ",") {
+		if addr = strings.TrimSpace(addr); addr != "" {
+			addrs = append(addrs, addr)
+		}
+	}
+	if len(addrs) == 0 {
+		return nil, trace.BadParameter("teleport address is empty")
+	}
+	parsed, err := utils.ParseAddrs(addrs)
+	return parsed, trace.Wrap(err)
+}
+
+// connectWithIdentityFile builds an auth client from a parsed identity file. All the
+// addresses are handed to the client, which fails over to the next one when an
+// endpoint stops answering.
+func connectWithIdentityFile(ctx context.Context, id *identityfile.IdentityFile, addrs []utils.NetAddr) (auth.ClientI, error) {
+	tlsConfig, err := id.TLSConfig()
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	sshConfig, err := id.SSHClientConfig()
+	if err != nil {
+		return nil, trace.Wrap(err)
+	}
+
+	authClientConfig := &authclient.Config{
+		TLS:         tlsConfig,
+		SSH:         sshConfig,
+		AuthServers: addrs,
+		Log:         log.StandardLogger(),
+	}
+
+	c, err := authclient.Connect(ctx, authClientConfig)
+	return c, trace.Wrap(err)
+}
@@ -48,7 +48,8 @@ type Options struct {
 	// DataDir is a path to the Teleport data dir e.g. /var/lib/teleport.
 	DataDir string
 
-	// Addr is an endpoint of Teleport e.g. 127.0.0.1:3025.
+	// Addr is one or several comma-separated Teleport endpoints e.g. 127.0.0.1:3025,
+	// tried in order when the first one stops answering.
 	Addr string
 
 	// Name is the bot name used to access Teleport Auth/Proxy/Tunnel server.
@@ -56,6 +57,12 @@ type Options struct {
 
 	// Role is a role allowed to manage Teleport resources.
 	Role string
+
+	// IdentityFilePath is a path to an identity file renewed by an external tbot,
+	// e.g. a mounted Kubernetes Secret or a shared directory. When set, the
+	// operator builds its Teleport client from this identity instead of
+	// bootstrapping an auth-local bot.
+	IdentityFilePath string
 }
 
 func createAuthClientConfig(opts Options) (*authclient.Config, error) {
@@ -73,7 +80,7 @@ func createAuthClientConfig(opts Options) (*authclient.Config, error) {
 		return nil, trace.Wrap(err)
 	}
 
-	cfg.AuthServers, err = utils.ParseAddrs([]string{opts.Addr})
+	cfg.AuthServers, err = parseAddrList(opts.Addr)
 	if err != nil {
 		return nil, trace.Wrap(err)
 	}
@@ -110,7 +117,7 @@ func sidecarRole(roleName string) (types.Role, error) {
 		Allow: types.RoleConditions{
 			Rules: []types.Rule{
 				{
-					Resources: []string{"role", "user"},
+					Resources: []string{"role", "user", "token", "cluster_auth_preference", "cluster_networking_config", "session_recording_config"},
 					Verbs:     []string{"*"},
 				},
 			},
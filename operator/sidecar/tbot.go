@@ -53,7 +53,15 @@ type Bot struct {
 	opts       Options
 }
 
-func (b *Bot) initializeConfig() {
+func (b *Bot) initializeConfig() error {
+	// The address option accepts a comma-separated list, but the embedded tbot only
+	// dials a single auth server: the bot joins through the first address, while the
+	// clients built by GetClient use the whole list.
+	addrs, err := parseAddrList(b.opts.Addr)
+	if err != nil {
+		return trace.Wrap(err)
+	}
+
 	// Initialize the memory stores. They contain identities renewed by the bot
 	// We're reading certs directly from them
 	rootMemoryStore := &config.DestinationMemory{}
@@ -79,7 +87,7 @@ func (b *Bot) initializeConfig() {
 			},
 		},
 		Debug:           false,
-		AuthServer:      b.opts.Addr,
+		AuthServer:      addrs[0].String(),
 		CertificateTTL:  DefaultCertificateTTL,
 		RenewalInterval: DefaultRenewalInterval,
 		Oneshot:         false,
@@ -95,6 +103,7 @@ func (b *Bot) initializeConfig() {
 		_ = rootMemoryStore.Write(artifact.Key, []byte{})
 	}
 
+	return nil
 }
 
 func (b *Bot) GetClient(ctx context.Context) (auth.ClientI, error) {
@@ -141,7 +150,9 @@ func (b *Bot) GetClient(ctx context.Context) (auth.ClientI, error) {
 		return nil, trace.Wrap(err)
 	}
 
-	authAddr, err := utils.ParseAddr(b.cfg.AuthServer)
+	// Unlike the embedded tbot, the auth client can fail over between all the
+	// configured addresses.
+	authAddrs, err := parseAddrList(b.opts.Addr)
 	if err != nil {
 		return nil, trace.Wrap(err)
 	}
@@ -149,7 +160,7 @@ func (b *Bot) GetClient(ctx context.Context) (auth.ClientI, error) {
 	authClientConfig := &authclient.Config{
 		TLS:         tlsConfig,
 		SSH:         sshConfig,
-		AuthServers: []utils.NetAddr{*authAddr},
+		AuthServers: authAddrs,
 		Log:         log.StandardLogger(),
 	}
 
@@ -226,7 +237,9 @@ func CreateAndBootstrapBot(ctx context.Context, opts Options) (*Bot, error) {
 		opts:       opts,
 	}
 
-	bot.initializeConfig()
+	if err := bot.initializeConfig(); err != nil {
+		return nil, trace.Wrap(err)
+	}
 	return bot, nil
 }
 
@@ -40,10 +40,11 @@ func onDaemonStart(cf *CLIConf) error {
 	}
 
 	err := teleterm.Serve(ctx, teleterm.Config{
-		HomeDir:            homeDir,
-		CertsDir:           cf.DaemonCertsDir,
-		Addr:               cf.DaemonAddr,
-		InsecureSkipVerify: cf.InsecureSkipVerify,
+		HomeDir:              homeDir,
+		CertsDir:             cf.DaemonCertsDir,
+		Addr:                 cf.DaemonAddr,
+		InsecureSkipVerify:   cf.InsecureSkipVerify,
+		TshdEventsServerAddr: cf.DaemonTshdEventsAddr,
 	})
 	if err != nil {
 		return trace.Wrap(err)
@@ -181,6 +181,8 @@ type CLIConf struct {
 	DaemonAddr string
 	// DaemonCertsDir is the directory containing certs used to create secure gRPC connection with daemon service
 	DaemonCertsDir string
+	// DaemonTshdEventsAddr is the address of the tshd events service served by the Electron app
+	DaemonTshdEventsAddr string
 	// DatabaseService specifies the database proxy server to log into.
 	DatabaseService string
 	// DatabaseUser specifies database user to embed in the certificate.
@@ -601,6 +603,7 @@ func Run(ctx context.Context, args []string, opts ...cliOption) error {
 	daemonStart := daemon.Command("start", "Starts tsh daemon service").Hidden()
 	daemonStart.Flag("addr", "Addr is the daemon listening address.").StringVar(&cf.DaemonAddr)
 	daemonStart.Flag("certs-dir", "Directory containing certs used to create secure gRPC connection with daemon service").StringVar(&cf.DaemonCertsDir)
+	daemonStart.Flag("tshd-events-addr", "Address of the tshd events service served by the Electron app").StringVar(&cf.DaemonTshdEventsAddr)
 
 	// AWS.
 	// Use Interspersed(false) to forward all flags to AWS CLI.
@@ -1455,11 +1458,6 @@ func onLogin(cf *CLIConf) error {
 	// -i flag specified? save the retrieved cert into an identity file
 	makeIdentityFile := (cf.IdentityFileOut != "")
 
-	// stdin hijack is OK for login, since it tsh doesn't read input after the
-	// login ceremony is complete.
-	// Only allow the option during the login ceremony.
-	tc.AllowStdinHijack = true
-
 	key, err := tc.Login(cf.Context)
 	if err != nil {
 		if !cf.ExplicitUsername && auth.IsInvalidLocalCredentialError(err) {
@@ -1467,7 +1465,6 @@ func onLogin(cf *CLIConf) error {
 		}
 		return trace.Wrap(err)
 	}
-	tc.AllowStdinHijack = false
 
 	// the login operation may update the username and should be considered the more
 	// "authoritative" source.